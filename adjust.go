@@ -0,0 +1,114 @@
+package jpx_business_day
+
+import (
+	"fmt"
+	"time"
+)
+
+// RollConvention - 休業日にあたった日付の調整方法
+type RollConvention int
+
+const (
+	RollConventionUnadjusted        RollConvention = iota // 調整しない
+	RollConventionFollowing                               // 翌営業日
+	RollConventionModifiedFollowing                       // 翌営業日、月をまたぐなら前営業日
+	RollConventionPreceding                               // 前営業日
+	RollConventionModifiedPreceding                       // 前営業日、月をまたぐなら翌営業日
+)
+
+// Adjust - targetが休業日ならconventionに従って営業日に調整する
+func (b *businessDay) Adjust(target time.Time, convention RollConvention) time.Time {
+	return adjust(b.IsBusinessDay, target, convention)
+}
+
+// adjust - targetが休業日ならconventionに従って営業日に調整する
+func adjust(isBusinessDay func(time.Time) bool, target time.Time, convention RollConvention) time.Time {
+	d := time.Date(target.Year(), target.Month(), target.Day(), 0, 0, 0, 0, time.Local)
+	roll := func(step int) time.Time {
+		r := d
+		for i := 0; i < searchLimitDays && !isBusinessDay(r); i++ {
+			r = r.AddDate(0, 0, step)
+		}
+		return r
+	}
+
+	switch convention {
+	case RollConventionFollowing:
+		return roll(1)
+	case RollConventionModifiedFollowing:
+		if r := roll(1); r.Month() == d.Month() {
+			return r
+		}
+		return roll(-1)
+	case RollConventionPreceding:
+		return roll(-1)
+	case RollConventionModifiedPreceding:
+		if r := roll(-1); r.Month() == d.Month() {
+			return r
+		}
+		return roll(1)
+	}
+	return d
+}
+
+// Frequency - 日付の間隔の月数
+type Frequency int
+
+const (
+	FrequencyMonthly    Frequency = 1  // 毎月
+	FrequencyQuarterly  Frequency = 3  // 四半期ごと
+	FrequencySemiAnnual Frequency = 6  // 半年ごと
+	FrequencyAnnual     Frequency = 12 // 毎年
+)
+
+// ScheduleGenerator - StartからEndまでの定期的な日付を作る
+type ScheduleGenerator struct {
+	Start      time.Time
+	End        time.Time
+	Frequency  Frequency
+	EndOfMonth bool // Startが月末なら、すべての日付を月末にする
+	Convention RollConvention
+}
+
+// Generate - StartからEndまでの日付をcalendarの営業日に調整して返す
+// StartとEndも含み、調整して同じ日になった日付はまとめる
+func (g ScheduleGenerator) Generate(calendar Calendar) ([]time.Time, error) {
+	if g.Frequency <= 0 {
+		return nil, fmt.Errorf("frequency is %d: %w", g.Frequency, InvalidArgumentError)
+	}
+	start := time.Date(g.Start.Year(), g.Start.Month(), g.Start.Day(), 0, 0, 0, 0, time.Local)
+	end := time.Date(g.End.Year(), g.End.Month(), g.End.Day(), 0, 0, 0, 0, time.Local)
+	if end.Before(start) {
+		return nil, fmt.Errorf("end %s is before start %s: %w", end.Format("2006/01/02"), start.Format("2006/01/02"), InvalidArgumentError)
+	}
+
+	eom := g.EndOfMonth && start.AddDate(0, 0, 1).Month() != start.Month()
+	var dates []time.Time
+	add := func(d time.Time) {
+		d = adjust(calendar.IsBusinessDay, d, g.Convention)
+		if len(dates) == 0 || !dates[len(dates)-1].Equal(d) {
+			dates = append(dates, d)
+		}
+	}
+	for k := 0; ; k++ {
+		d := addMonths(start, k*int(g.Frequency), eom)
+		if !d.Before(end) {
+			break
+		}
+		add(d)
+	}
+	add(end)
+	return dates, nil
+}
+
+// addMonths - dateのmonthsか月後の日付
+// 月末を超える日は月末にし、eomなら常に月末にする
+func addMonths(date time.Time, months int, eom bool) time.Time {
+	first := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, time.Local)
+	last := first.AddDate(0, 1, -1).Day()
+	day := date.Day()
+	if eom || day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, time.Local)
+}
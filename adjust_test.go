@@ -0,0 +1,146 @@
+package jpx_business_day
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_businessDay_Adjust(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local): {{Name: "憲法記念日"}},
+			time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local): {{Name: "みどりの日"}},
+			time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local): {{Name: "こどもの日"}},
+		},
+		lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name       string
+		target     time.Time
+		convention RollConvention
+		want       time.Time
+	}{
+		{name: "営業日はどれでもそのまま",
+			target:     time.Date(2021, 4, 30, 10, 0, 0, 0, time.Local),
+			convention: RollConventionModifiedFollowing,
+			want:       time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local)},
+		{name: "Unadjustedは休業日でもそのまま",
+			target:     time.Date(2021, 5, 1, 0, 0, 0, 0, time.Local),
+			convention: RollConventionUnadjusted,
+			want:       time.Date(2021, 5, 1, 0, 0, 0, 0, time.Local)},
+		{name: "Followingは連休明け",
+			target:     time.Date(2021, 5, 1, 0, 0, 0, 0, time.Local),
+			convention: RollConventionFollowing,
+			want:       time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)},
+		{name: "Followingは月をまたいでもよい",
+			target:     time.Date(2021, 7, 31, 0, 0, 0, 0, time.Local),
+			convention: RollConventionFollowing,
+			want:       time.Date(2021, 8, 2, 0, 0, 0, 0, time.Local)},
+		{name: "ModifiedFollowingは月をまたぐなら前営業日",
+			target:     time.Date(2021, 7, 31, 0, 0, 0, 0, time.Local),
+			convention: RollConventionModifiedFollowing,
+			want:       time.Date(2021, 7, 30, 0, 0, 0, 0, time.Local)},
+		{name: "Precedingは月をまたいでもよい",
+			target:     time.Date(2021, 5, 1, 0, 0, 0, 0, time.Local),
+			convention: RollConventionPreceding,
+			want:       time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local)},
+		{name: "ModifiedPrecedingは月をまたぐなら翌営業日",
+			target:     time.Date(2021, 5, 1, 0, 0, 0, 0, time.Local),
+			convention: RollConventionModifiedPreceding,
+			want:       time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := bd.Adjust(test.target, test.convention)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_ScheduleGenerator_Generate(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name      string
+		generator ScheduleGenerator
+		want      []time.Time
+		wantErr   error
+	}{
+		{name: "毎月の月末をModifiedFollowingで調整する",
+			generator: ScheduleGenerator{
+				Start:      time.Date(2021, 1, 31, 0, 0, 0, 0, time.Local),
+				End:        time.Date(2021, 7, 31, 0, 0, 0, 0, time.Local),
+				Frequency:  FrequencyMonthly,
+				EndOfMonth: true,
+				Convention: RollConventionModifiedFollowing,
+			},
+			want: []time.Time{
+				time.Date(2021, 1, 29, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 2, 26, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 3, 31, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 5, 31, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 6, 30, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 7, 30, 0, 0, 0, 0, time.Local),
+			}},
+		{name: "月末ルールがなければ日を保つ",
+			generator: ScheduleGenerator{
+				Start:      time.Date(2021, 2, 28, 0, 0, 0, 0, time.Local),
+				End:        time.Date(2021, 11, 30, 0, 0, 0, 0, time.Local),
+				Frequency:  FrequencyQuarterly,
+				Convention: RollConventionUnadjusted,
+			},
+			want: []time.Time{
+				time.Date(2021, 2, 28, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 5, 28, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 8, 28, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 11, 28, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 11, 30, 0, 0, 0, 0, time.Local),
+			}},
+		{name: "月末ルールがあれば月末にそろえる",
+			generator: ScheduleGenerator{
+				Start:      time.Date(2021, 2, 28, 0, 0, 0, 0, time.Local),
+				End:        time.Date(2021, 11, 30, 0, 0, 0, 0, time.Local),
+				Frequency:  FrequencyQuarterly,
+				EndOfMonth: true,
+				Convention: RollConventionUnadjusted,
+			},
+			want: []time.Time{
+				time.Date(2021, 2, 28, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 5, 31, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 8, 31, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 11, 30, 0, 0, 0, 0, time.Local),
+			}},
+		{name: "間隔がなければエラー",
+			generator: ScheduleGenerator{
+				Start: time.Date(2021, 1, 31, 0, 0, 0, 0, time.Local),
+				End:   time.Date(2021, 7, 31, 0, 0, 0, 0, time.Local),
+			},
+			wantErr: InvalidArgumentError},
+		{name: "EndがStartより前ならエラー",
+			generator: ScheduleGenerator{
+				Start:     time.Date(2021, 7, 31, 0, 0, 0, 0, time.Local),
+				End:       time.Date(2021, 1, 31, 0, 0, 0, 0, time.Local),
+				Frequency: FrequencyMonthly,
+			},
+			wantErr: InvalidArgumentError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := test.generator.Generate(bd)
+			if !reflect.DeepEqual(test.want, got) || !errors.Is(err, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantErr, got, err)
+			}
+		})
+	}
+}
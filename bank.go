@@ -0,0 +1,57 @@
+package jpx_business_day
+
+import "time"
+
+// NewBankCalendar - 全国銀行の休日のカレンダー
+// 土曜日、日曜日、12/31から1/3までと、bdの休日のうち取引所が独自に定める休業日以外を休日にする
+// 種類が未指定の休日は祝日法による休日として扱う
+func NewBankCalendar(bd BusinessDay) Calendar {
+	c := &bankCalendar{isNationalHoliday: func(target time.Time) bool {
+		for _, h := range bd.Holidays(target, target) {
+			if h.Kind != HolidayKindExchange {
+				return true
+			}
+		}
+		return false
+	}}
+	// 1日ごとに休日の一覧を作らないように、持っている休日で直接判定する
+	if b, ok := bd.(*businessDay); ok {
+		c.isNationalHoliday = func(target time.Time) bool {
+			b.mtx.Lock()
+			defer b.mtx.Unlock()
+
+			return b.hasHolidayOtherThan(HolidayKindExchange, target)
+		}
+	}
+	return c
+}
+
+type bankCalendar struct {
+	isNationalHoliday func(target time.Time) bool // 取引所が独自に定める休業日以外の休日かどうか
+}
+
+// IsBusinessDay - 銀行の営業日かどうか
+func (c *bankCalendar) IsBusinessDay(target time.Time) bool {
+	return !c.IsHoliday(target)
+}
+
+// IsHoliday - 銀行の休日かどうか
+func (c *bankCalendar) IsHoliday(target time.Time) bool {
+	// 土曜日、日曜日は常に休み
+	if target.Weekday() == time.Saturday || target.Weekday() == time.Sunday {
+		return true
+	}
+
+	// 年末年始は休み
+	if (target.Month() == time.December && target.Day() == 31) || (target.Month() == time.January && target.Day() <= 3) {
+		return true
+	}
+
+	return c.isNationalHoliday(target)
+}
+
+// AddBusinessDays - targetからn営業日後の日付
+// nが負ならn営業日前、0ならtargetの日付を返す
+func (c *bankCalendar) AddBusinessDays(target time.Time, n int) time.Time {
+	return addBusinessDaysBy(c.IsBusinessDay, target, n)
+}
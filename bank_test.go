@@ -0,0 +1,59 @@
+package jpx_business_day
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_bankCalendar_IsHoliday(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2020, 10, 1, 0, 0, 0, 0, time.Local):  {{Name: "休業日", Kind: HolidayKindExchange}},
+			time.Date(2020, 11, 3, 0, 0, 0, 0, time.Local):  {{Name: "文化の日", Kind: HolidayKindNational}},
+			time.Date(2020, 12, 31, 0, 0, 0, 0, time.Local): {{Name: "休業日", Kind: HolidayKindExchange}},
+		},
+		lastHoliday: time.Date(2020, 12, 31, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name string
+		arg  time.Time
+		want bool
+	}{
+		{name: "祝日は休日", arg: time.Date(2020, 11, 3, 0, 0, 0, 0, time.Local), want: true},
+		{name: "取引所だけの休業日は営業日", arg: time.Date(2020, 10, 1, 0, 0, 0, 0, time.Local), want: false},
+		{name: "12/31は休日", arg: time.Date(2020, 12, 31, 0, 0, 0, 0, time.Local), want: true},
+		{name: "休日一覧になくても1/3までは休日", arg: time.Date(2023, 1, 3, 0, 0, 0, 0, time.Local), want: true},
+		{name: "1/4は営業日", arg: time.Date(2023, 1, 4, 0, 0, 0, 0, time.Local), want: false},
+		{name: "土曜日は休日", arg: time.Date(2020, 10, 3, 0, 0, 0, 0, time.Local), want: true},
+		{name: "平日は営業日", arg: time.Date(2020, 10, 2, 0, 0, 0, 0, time.Local), want: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			// *businessDay以外のBusinessDayはHolidaysで判定する
+			for _, cal := range []Calendar{NewBankCalendar(bd), NewBankCalendar(struct{ BusinessDay }{bd})} {
+				got := cal.IsHoliday(test.arg)
+				if !reflect.DeepEqual(test.want, got) || got == cal.IsBusinessDay(test.arg) {
+					t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+				}
+			}
+		})
+	}
+}
+
+func Test_bankCalendar_AddBusinessDays(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2020, 10, 1, 0, 0, 0, 0, time.Local): {{Name: "休業日", Kind: HolidayKindExchange}},
+		},
+		lastHoliday: time.Date(2020, 12, 31, 0, 0, 0, 0, time.Local)}
+	got := NewBankCalendar(bd).AddBusinessDays(time.Date(2020, 9, 30, 0, 0, 0, 0, time.Local), 1)
+	want := time.Date(2020, 10, 1, 0, 0, 0, 0, time.Local)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
@@ -0,0 +1,188 @@
+package jpx_business_day
+
+import (
+	"math/bits"
+	"time"
+)
+
+// dayBitmap - 休日情報のある期間の営業日を1日1bitで持つカレンダー
+// 期間外は土曜日、日曜日だけが休日として扱う
+type dayBitmap struct {
+	first  int64    // 期間の最初の日の通し番号
+	days   int64    // 期間の日数
+	bits   []uint64 // 営業日なら1
+	counts []int64  // counts[i]はbits[:i]に含まれる営業日数
+}
+
+// newDayBitmap - holidaysを含む年の初めから年末までの東証現物市場のカレンダーを作る
+func newDayBitmap(holidays map[time.Time][]Holiday) *dayBitmap {
+	if len(holidays) == 0 {
+		return &dayBitmap{}
+	}
+
+	var from, to time.Time
+	for d := range holidays {
+		if from.IsZero() || d.Before(from) {
+			from = d
+		}
+		if to.IsZero() || d.After(to) {
+			to = d
+		}
+	}
+	first := dayNumber(time.Date(from.Year(), 1, 1, 0, 0, 0, 0, time.Local))
+	last := dayNumber(time.Date(to.Year(), 12, 31, 0, 0, 0, 0, time.Local))
+
+	m := &dayBitmap{first: first, days: last - first + 1}
+	m.bits = make([]uint64, (m.days+63)/64)
+	m.counts = make([]int64, len(m.bits)+1)
+	for i := int64(0); i < m.days; i++ {
+		n := first + i
+		if !isWeekend(n) {
+			if !closes(holidays[dateOfNumber(n)], MarketTSECash) {
+				m.bits[i/64] |= 1 << uint(i%64)
+			}
+		}
+	}
+	for i, w := range m.bits {
+		m.counts[i+1] = m.counts[i] + int64(bits.OnesCount64(w))
+	}
+	return m
+}
+
+// prefix - 期間の最初からi日分に含まれる営業日数
+func (m *dayBitmap) prefix(i int64) int64 {
+	if i <= 0 {
+		return 0
+	}
+	if i >= m.days {
+		return m.counts[len(m.bits)]
+	}
+	mask := uint64(1)<<uint(i%64) - 1
+	return m.counts[i/64] + int64(bits.OnesCount64(m.bits[i/64]&mask))
+}
+
+// count - 通し番号がfrom以上to未満の日に含まれる営業日数
+func (m *dayBitmap) count(from, to int64) int64 {
+	if to <= from {
+		return 0
+	}
+	end := m.first + m.days
+	var c int64
+	if from < m.first {
+		c += weekdays(from, minInt64(to, m.first))
+	}
+	if from < end && m.first < to {
+		c += m.prefix(minInt64(to, end)-m.first) - m.prefix(maxInt64(from, m.first)-m.first)
+	}
+	if end < to {
+		c += weekdays(maxInt64(from, end), to)
+	}
+	return c
+}
+
+// add - 通し番号dの日からn営業日後の日の通し番号
+// nが負ならn営業日前、0ならdを返す
+func (m *dayBitmap) add(d int64, n int64) int64 {
+	switch {
+	case n > 0:
+		// d+1からx+1までの営業日数がn以上になる最小のxを探す
+		lo, hi := d, d+2*n+7
+		for m.count(d+1, hi+1) < n {
+			lo, hi = hi, d+2*(hi-d)
+		}
+		for hi-lo > 1 {
+			mid := lo + (hi-lo)/2
+			if m.count(d+1, mid+1) < n {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		return hi
+	case n < 0:
+		// xからdまでの営業日数が-n以上になる最大のxを探す
+		lo, hi := d+2*n-7, d
+		for m.count(lo, d) < -n {
+			hi, lo = lo, d-2*(d-lo)
+		}
+		for hi-lo > 1 {
+			mid := lo + (hi-lo)/2
+			if m.count(mid, d) < -n {
+				hi = mid
+			} else {
+				lo = mid
+			}
+		}
+		return lo
+	}
+	return d
+}
+
+// calendar - 営業日のカレンダー
+// 休日情報が変わったら作り直す
+// 呼び出し側でロックを取っておくこと
+func (b *businessDay) calendar() *dayBitmap {
+	if b.bitmap == nil {
+		b.bitmap = newDayBitmap(b.holidays)
+	}
+	return b.bitmap
+}
+
+// BusinessDaysBetween - fromからtoの前日までの営業日数
+// toがfromより前なら、toからfromの前日までの営業日数を負にして返す
+func (b *businessDay) BusinessDaysBetween(from, to time.Time) int {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	f, t := dayNumber(from), dayNumber(to)
+	if t < f {
+		return -int(b.calendar().count(t, f))
+	}
+	return int(b.calendar().count(f, t))
+}
+
+// dayNumber - 1970/01/01を0とした日付の通し番号
+func dayNumber(t time.Time) int64 {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
+
+// dateOfNumber - 通し番号の日付
+func dateOfNumber(n int64) time.Time {
+	u := time.Unix(n*86400, 0).UTC()
+	return time.Date(u.Year(), u.Month(), u.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// isWeekend - 通し番号の日が土曜日か日曜日か
+// 1970/01/01は木曜日
+func isWeekend(n int64) bool {
+	w := ((n+4)%7 + 7) % 7
+	return w == int64(time.Saturday) || w == int64(time.Sunday)
+}
+
+// weekdays - 通し番号がfrom以上to未満の日に含まれる平日の数
+func weekdays(from, to int64) int64 {
+	if to <= from {
+		return 0
+	}
+	c := (to - from) / 7 * 5
+	for n := from + (to-from)/7*7; n < to; n++ {
+		if !isWeekend(n) {
+			c++
+		}
+	}
+	return c
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,128 @@
+package jpx_business_day
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_businessDay_BusinessDaysBetween(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local):   {{Name: "元日"}},
+			time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local):   {{Name: "憲法記念日"}},
+			time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local):   {{Name: "みどりの日"}},
+			time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local):   {{Name: "こどもの日"}},
+			time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local): {{Name: "休業日"}},
+		},
+		lastHoliday: time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want int
+	}{
+		{name: "同じ日なら0",
+			from: time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 4, 30, 23, 0, 0, 0, time.Local),
+			want: 0},
+		{name: "toの日は含まない",
+			from: time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local),
+			want: 2},
+		{name: "toがfromより前なら負",
+			from: time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			want: -2},
+		{name: "休日情報の期間より前は平日を数える",
+			from: time.Date(2020, 12, 28, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 1, 5, 0, 0, 0, 0, time.Local),
+			want: 5},
+		{name: "休日情報の期間より後は平日を数える",
+			from: time.Date(2022, 12, 26, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2023, 1, 10, 0, 0, 0, 0, time.Local),
+			want: 11},
+		{name: "期間の前後をまたいで数える",
+			from: time.Date(2020, 1, 1, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local),
+			want: 1043 - 4},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := bd.BusinessDaysBetween(test.from, test.to)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_businessDay_AddBusinessDays_Walk(t *testing.T) {
+	t.Parallel()
+	bd := benchmarkBusinessDay()
+	from := time.Date(1999, 12, 20, 0, 0, 0, 0, time.Local)
+	for _, n := range []int{1, 2, 5, 19, 250, 1000, 9000} {
+		for _, sign := range []int{1, -1} {
+			want := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.Local)
+			for c := 0; c < n; {
+				want = want.AddDate(0, 0, sign)
+				if !bd.IsHoliday(want) {
+					c++
+				}
+			}
+			got := bd.AddBusinessDays(from, sign*n)
+			if !reflect.DeepEqual(want, got) {
+				t.Errorf("%s error n=%d\nwant: %+v\ngot: %+v\n", t.Name(), sign*n, want, got)
+			}
+			if between := bd.BusinessDaysBetween(from.AddDate(0, 0, 1), got.AddDate(0, 0, 1)); sign > 0 && between != n {
+				t.Errorf("%s error n=%d\nwant: %+v\ngot: %+v\n", t.Name(), n, n, between)
+			}
+		}
+	}
+}
+
+// benchmarkBusinessDay - 2000年から2049年までの年末年始と毎月15日を休日にしたbusinessDay
+func benchmarkBusinessDay() *businessDay {
+	holidays := map[time.Time][]Holiday{}
+	for y := 2000; y < 2050; y++ {
+		holidays[time.Date(y, 1, 1, 0, 0, 0, 0, time.Local)] = []Holiday{{Name: "元日"}}
+		holidays[time.Date(y, 1, 2, 0, 0, 0, 0, time.Local)] = []Holiday{{Name: "休業日"}}
+		holidays[time.Date(y, 1, 3, 0, 0, 0, 0, time.Local)] = []Holiday{{Name: "休業日"}}
+		for m := time.January; m <= time.December; m++ {
+			holidays[time.Date(y, m, 15, 0, 0, 0, 0, time.Local)] = []Holiday{{Name: "祝日"}}
+		}
+		holidays[time.Date(y, 12, 31, 0, 0, 0, 0, time.Local)] = []Holiday{{Name: "休業日"}}
+	}
+	return &businessDay{holidays: holidays, lastHoliday: time.Date(2049, 12, 31, 0, 0, 0, 0, time.Local)}
+}
+
+func Benchmark_businessDay_BusinessDaysBetween(b *testing.B) {
+	bd := benchmarkBusinessDay()
+	from := time.Date(2000, 1, 1, 0, 0, 0, 0, time.Local)
+	to := time.Date(2049, 12, 31, 0, 0, 0, 0, time.Local)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bd.BusinessDaysBetween(from, to)
+	}
+}
+
+func Benchmark_businessDay_AddBusinessDays(b *testing.B) {
+	bd := benchmarkBusinessDay()
+	from := time.Date(2000, 1, 1, 0, 0, 0, 0, time.Local)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bd.AddBusinessDays(from, 10000)
+	}
+}
+
+func Benchmark_businessDay_NthBusinessDayOfYear(b *testing.B) {
+	bd := benchmarkBusinessDay()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = bd.NthBusinessDayOfYear(2030, -1)
+	}
+}
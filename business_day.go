@@ -4,17 +4,45 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
-	"regexp"
+	"sort"
 	"sync"
 	"time"
 )
 
-func NewBusinessDay() BusinessDay {
+func NewBusinessDay(opts ...Option) BusinessDay {
 	bd := &businessDay{
-		url:      "https://www.jpx.co.jp/corporate/about-jpx/calendar/",
-		holidays: map[time.Time]string{},
+		url:                JPXCalendarURL,
+		holidays:           map[time.Time][]Holiday{},
+		holidayTradingDays: map[time.Time]struct{}{},
+	}
+	for _, opt := range opts {
+		opt(bd)
+	}
+	return bd
+}
+
+// NewBusinessDayFromHolidays - 休日の一覧から営業日情報を作る
+// 保存しておいた休日を使うときなど、JPXのページを取得せずに使える
+// 休日の種類、市場、取得元はそのまま持ち、市場を指定した休日はその市場だけを休みにする
+// Refreshすると休日はJPXのページから取得したものに置き換わる
+func NewBusinessDayFromHolidays(holidays []Holiday, lastUpdateDate time.Time, opts ...Option) BusinessDay {
+	bd := &businessDay{
+		url:                JPXCalendarURL,
+		holidays:           map[time.Time][]Holiday{},
+		holidayTradingDays: map[time.Time]struct{}{},
+		lastUpdateDate:     lastUpdateDate,
+	}
+	for _, h := range holidays {
+		d := time.Date(h.Date.Year(), h.Date.Month(), h.Date.Day(), 0, 0, 0, 0, time.Local)
+		h.Date = d
+		bd.holidays[d] = append(bd.holidays[d], h)
+		if d.After(bd.lastHoliday) {
+			bd.lastHoliday = d
+		}
+	}
+	for _, opt := range opts {
+		opt(bd)
 	}
 	return bd
 }
@@ -25,14 +53,50 @@ type BusinessDay interface {
 	Refresh(ctx context.Context) error
 	LastHoliday() time.Time
 	LastUpdateDate() time.Time
+	IsMarketOpen(target time.Time) bool
+	IsDerivativesOpen(target time.Time) bool
+	DerivativesSession(target time.Time) (Session, time.Time)
+	TradeDate(target time.Time) time.Time
+	IsDerivativesTradingDay(target time.Time) bool
+	SetHolidayTradingDays(dates []time.Time)
+	AddBusinessDays(target time.Time, n int) time.Time
+	SettlementDate(tradeDate time.Time, convention SettlementConvention) (time.Time, error)
+	SQDate(year int, month time.Month) time.Time
+	LastTradingDay(year int, month time.Month) time.Time
+	WeeklyOptionSQDate(year int, month time.Month, week int) (time.Time, error)
+	WeeklyOptionLastTradingDay(year int, month time.Month, week int) (time.Time, error)
+	RecordDateInfo(recordDate time.Time) RecordDateInfo
+	FirstBusinessDayOfMonth(year int, month time.Month) (time.Time, error)
+	LastBusinessDayOfMonth(year int, month time.Month) (time.Time, error)
+	NthBusinessDayOfMonth(year int, month time.Month, n int) (time.Time, error)
+	FirstBusinessDayOfQuarter(year int, quarter int) (time.Time, error)
+	LastBusinessDayOfQuarter(year int, quarter int) (time.Time, error)
+	NthBusinessDayOfQuarter(year int, quarter int, n int) (time.Time, error)
+	FirstBusinessDayOfYear(year int) (time.Time, error)
+	LastBusinessDayOfYear(year int) (time.Time, error)
+	NthBusinessDayOfYear(year int, n int) (time.Time, error)
+	Each(from, to time.Time, f func(date time.Time) bool)
+	BusinessDaysBetween(from, to time.Time) int
+	Adjust(target time.Time, convention RollConvention) time.Time
+	Holidays(from, to time.Time) []Holiday
+	Today() time.Time
+	IsTodayBusinessDay() bool
+	IsOpenNow() bool
+	NextBusinessDayFromNow() time.Time
 }
 
 type businessDay struct {
-	url            string
-	holidays       map[time.Time]string
-	lastHoliday    time.Time
-	lastUpdateDate time.Time
-	mtx            sync.Mutex
+	url                string
+	holidays           map[time.Time][]Holiday
+	holidayTradingDays map[time.Time]struct{}
+	bitmap             *dayBitmap
+	clock              Clock
+	metrics            Metrics
+	logger             Logger
+	lastHoliday        time.Time
+	lastUpdateDate     time.Time
+	mtx                sync.Mutex
+	refreshMtx         sync.Mutex // Refreshを1つずつ行うためのロック
 }
 
 // IsBusinessDay - 営業日かどうか
@@ -45,34 +109,121 @@ func (b *businessDay) IsHoliday(target time.Time) bool {
 	b.mtx.Lock()
 	defer b.mtx.Unlock()
 
+	return b.isHoliday(target)
+}
+
+// isHoliday - 東証現物市場の休日かどうか
+// 呼び出し側でロックを取っておくこと
+func (b *businessDay) isHoliday(target time.Time) bool {
+	return b.isHolidayIn(MarketTSECash, target)
+}
+
+// isHolidayIn - marketの休日かどうか
+// 呼び出し側でロックを取っておくこと
+func (b *businessDay) isHolidayIn(market Market, target time.Time) bool {
 	// 土曜日、日曜日は常に休み
 	if target.Weekday() == time.Saturday || target.Weekday() == time.Sunday {
 		return true
 	}
 
-	// 祝日一覧にあれば休日
+	// 祝日一覧にmarketを休みにする休日があれば休日
 	targetDate := time.Date(target.Year(), target.Month(), target.Day(), 0, 0, 0, 0, time.Local)
-	_, ok := b.holidays[targetDate]
-	return ok
+	return closes(b.holidays[targetDate], market)
+}
+
+// hasHolidayOtherThan - targetの日付にkind以外の種類の休日があるかどうか
+// 呼び出し側でロックを取っておくこと
+func (b *businessDay) hasHolidayOtherThan(kind HolidayKind, target time.Time) bool {
+	targetDate := time.Date(target.Year(), target.Month(), target.Day(), 0, 0, 0, 0, time.Local)
+	for _, h := range b.holidays[targetDate] {
+		if h.Kind != kind {
+			return true
+		}
+	}
+	return false
+}
+
+// closes - holidaysのいずれかがmarketを休みにするかどうか
+// 市場が未指定の休日は全ての市場を休みにする
+func closes(holidays []Holiday, market Market) bool {
+	for _, h := range holidays {
+		if h.Market == MarketUnspecified || h.Market == market {
+			return true
+		}
+	}
+	return false
+}
+
+// AddBusinessDays - targetからn営業日後の日付
+// nが負ならn営業日前、0ならtargetの日付を返す
+func (b *businessDay) AddBusinessDays(target time.Time, n int) time.Time {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.addBusinessDays(target, n)
+}
+
+// addBusinessDays - targetからn営業日後の日付
+// 呼び出し側でロックを取っておくこと
+func (b *businessDay) addBusinessDays(target time.Time, n int) time.Time {
+	return dateOfNumber(b.calendar().add(dayNumber(target), int64(n)))
 }
 
 var (
-	NotOKStatusError = errors.New("not ok status error")
-	TimeParseError   = errors.New("time parse error")
+	NotOKStatusError     = errors.New("not ok status error")
+	TimeParseError       = errors.New("time parse error")
+	NotBusinessDayError  = errors.New("not business day error")
+	InvalidArgumentError = errors.New("invalid argument error")
+	OutOfRangeError      = errors.New("out of range error")
 )
 
-func (b *businessDay) Refresh(ctx context.Context) (err error) {
+// Refresh - JPXのページから休日の一覧を取得し直す
+// ページの取得と読み取りはロックを取らずに行い、休日の入れ替えだけをロックを取って行う
+// 計測が設定されていれば、結果を計測に渡す
+func (b *businessDay) Refresh(ctx context.Context) error {
+	b.refreshMtx.Lock()
+	defer b.refreshMtx.Unlock()
+
+	start := time.Now()
+	update, holidays, err := b.fetch(ctx)
+
 	b.mtx.Lock()
 	defer b.mtx.Unlock()
 
+	if err == nil {
+		b.swap(update, holidays)
+	} else {
+		b.log().Error("refresh failed", "url", b.url, "duration", time.Since(start), "error", err)
+	}
+	if b.metrics != nil {
+		result := RefreshResult{
+			At:             b.now(),
+			Duration:       time.Since(start),
+			Err:            err,
+			LastUpdateDate: b.lastUpdateDate,
+			LastHoliday:    b.lastHoliday,
+		}
+		if !b.lastHoliday.IsZero() {
+			result.CoveredUntil = b.coveredUntil()
+		}
+		b.metrics.ObserveRefresh(result)
+	}
+	return err
+}
+
+// fetch - JPXのページを取得して、ページの更新日と休日の一覧を読み取る
+// 休日情報には触れないので、ロックを取らずに呼ぶ
+func (b *businessDay) fetch(ctx context.Context) (update time.Time, holidays []Holiday, err error) {
+	logger := b.log()
+	logger.Debug("fetching page", "url", b.url)
 	req, err := http.NewRequestWithContext(ctx, "GET", b.url, nil)
 	if err != nil {
-		return err
+		return time.Time{}, nil, err
 	}
 	client := &http.Client{}
 	res, err := client.Do(req)
 	if err != nil {
-		return err
+		return time.Time{}, nil, err
 	}
 	defer func() {
 		if closeErr := res.Body.Close(); err == nil && closeErr != nil {
@@ -80,40 +231,58 @@ func (b *businessDay) Refresh(ctx context.Context) (err error) {
 		}
 	}()
 
+	logger.Info("fetched page", "url", b.url, "status", res.StatusCode)
 	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("status is %d: %w", res.StatusCode, NotOKStatusError)
+		return time.Time{}, nil, fmt.Errorf("status is %d: %w", res.StatusCode, NotOKStatusError)
 	}
+	return parsePage(res.Body, logger)
+}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return err
-	}
-	bodyStr := string(body)
+// swap - 休日情報をupdateとholidaysに入れ替える
+// 呼び出し側でロックを取っておくこと
+func (b *businessDay) swap(update time.Time, holidays []Holiday) {
+	previousUpdateDate, previousHolidays := b.lastUpdateDate, len(b.holidays)
+	b.lastUpdateDate = update
 
-	updateDate := regexp.MustCompile(`<li>(\d{4}/\d{2}/\d{2}) 更新</li>`).FindAllStringSubmatch(bodyStr, -1)
-	if len(updateDate) < 1 || len(updateDate[0]) < 2 {
-		return fmt.Errorf("udpate datetime is not found, %w", TimeParseError)
-	}
-	update, err := time.ParseInLocation("2006/01/02", updateDate[0][1], time.Local)
-	if err != nil {
-		return fmt.Errorf("%v, %w", err, TimeParseError)
+	b.holidays = map[time.Time][]Holiday{}
+	b.bitmap = nil
+	for _, h := range holidays {
+		h.Source = b.url
+		b.holidays[h.Date] = append(b.holidays[h.Date], h)
+		b.lastHoliday = h.Date
 	}
-	b.lastUpdateDate = update
+	b.log().Info("swapped holidays",
+		"lastUpdateDate", b.lastUpdateDate, "previousLastUpdateDate", previousUpdateDate,
+		"holidays", len(b.holidays), "previousHolidays", previousHolidays,
+		"lastHoliday", b.lastHoliday)
+}
 
-	b.holidays = map[time.Time]string{}
-	holidays := regexp.MustCompile(`<tr><td class="a-center">(\d{4}/\d{2}/\d{2})\S+</td><td class="a-center">(\S+)</td></tr>`).FindAllStringSubmatch(bodyStr, -1)
-	for _, holiday := range holidays {
-		if len(holiday) != 3 {
+// Holidays - fromからtoまでの取得した休日を日付順に返す
+// 土曜日、日曜日は休日一覧に載っているものだけを返す
+// 同じ日に複数の休日があれば市場の順に返す
+func (b *businessDay) Holidays(from, to time.Time) []Holiday {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	f := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.Local)
+	t := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.Local)
+	holidays := make([]Holiday, 0)
+	for d, hs := range b.holidays {
+		if d.Before(f) || d.After(t) {
 			continue
 		}
-
-		if t, err := time.ParseInLocation("2006/01/02", holiday[1], time.Local); err == nil {
-			b.holidays[t] = holiday[2]
-			b.lastHoliday = t
+		for _, h := range hs {
+			h.Date = d
+			holidays = append(holidays, h)
 		}
 	}
-
-	return nil
+	sort.SliceStable(holidays, func(i, j int) bool {
+		if !holidays[i].Date.Equal(holidays[j].Date) {
+			return holidays[i].Date.Before(holidays[j].Date)
+		}
+		return holidays[i].Market < holidays[j].Market
+	})
+	return holidays
 }
 
 // LastHoliday - 取得した最終の休日
@@ -90,26 +90,26 @@ func Test_businessDay_IsHoliday(t *testing.T) {
 			want:        false},
 		{name: "lastHolidayがholidaysにあればtrue",
 			businessDay: &businessDay{
-				holidays: map[time.Time]string{
-					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): "休業日",
+				holidays: map[time.Time][]Holiday{
+					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): {{Name: "休業日"}},
 				},
 				lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)},
 			arg:  time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local),
 			want: true},
 		{name: "lastHoliday以前の平日がholidaysにあればtrue",
 			businessDay: &businessDay{
-				holidays: map[time.Time]string{
-					time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local):   "こどもの日",
-					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): "休業日",
+				holidays: map[time.Time][]Holiday{
+					time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local):   {{Name: "こどもの日"}},
+					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): {{Name: "休業日"}},
 				},
 				lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)},
 			arg:  time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local),
 			want: true},
 		{name: "lastHoliday以前の平日がholidaysになければfalse",
 			businessDay: &businessDay{
-				holidays: map[time.Time]string{
-					time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local):   "こどもの日",
-					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): "休業日",
+				holidays: map[time.Time][]Holiday{
+					time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local):   {{Name: "こどもの日"}},
+					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): {{Name: "休業日"}},
 				},
 				lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)},
 			arg:  time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local),
@@ -158,26 +158,26 @@ func Test_businessDay_IsBusinessDay(t *testing.T) {
 			want:        true},
 		{name: "lastHolidayがholidaysにあればfalse",
 			businessDay: &businessDay{
-				holidays: map[time.Time]string{
-					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): "休業日",
+				holidays: map[time.Time][]Holiday{
+					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): {{Name: "休業日"}},
 				},
 				lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)},
 			arg:  time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local),
 			want: false},
 		{name: "lastHoliday以前の平日がholidaysにあればfalse",
 			businessDay: &businessDay{
-				holidays: map[time.Time]string{
-					time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local):   "こどもの日",
-					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): "休業日",
+				holidays: map[time.Time][]Holiday{
+					time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local):   {{Name: "こどもの日"}},
+					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): {{Name: "休業日"}},
 				},
 				lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)},
 			arg:  time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local),
 			want: false},
 		{name: "lastHoliday以前の平日がholidaysになければtrue",
 			businessDay: &businessDay{
-				holidays: map[time.Time]string{
-					time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local):   "こどもの日",
-					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): "休業日",
+				holidays: map[time.Time][]Holiday{
+					time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local):   {{Name: "こどもの日"}},
+					time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): {{Name: "休業日"}},
 				},
 				lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)},
 			arg:  time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local),
@@ -196,6 +196,128 @@ func Test_businessDay_IsBusinessDay(t *testing.T) {
 	}
 }
 
+func Test_businessDay_AddBusinessDays(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local): {{Name: "憲法記念日"}},
+			time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local): {{Name: "みどりの日"}},
+			time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local): {{Name: "こどもの日"}},
+		},
+		lastHoliday: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name   string
+		target time.Time
+		n      int
+		want   time.Time
+	}{
+		{name: "0なら日付だけにして返す",
+			target: time.Date(2021, 5, 1, 10, 0, 0, 0, time.Local),
+			n:      0,
+			want:   time.Date(2021, 5, 1, 0, 0, 0, 0, time.Local)},
+		{name: "連休をまたいで進む",
+			target: time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			n:      2,
+			want:   time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local)},
+		{name: "休日から進むと最初の営業日が1営業日後",
+			target: time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local),
+			n:      1,
+			want:   time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)},
+		{name: "負なら連休をまたいで戻る",
+			target: time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local),
+			n:      -2,
+			want:   time.Date(2021, 4, 29, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := bd.AddBusinessDays(test.target, test.n)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_businessDay_Holidays(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): {{Name: "休業日", Kind: HolidayKindExchange}},
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local):   {{Name: "元日", Kind: HolidayKindNational}},
+			time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local): {
+				{Name: "システム障害", Market: MarketOSEDerivatives, Source: "counterparty.ics"},
+				{Name: "こどもの日", Kind: HolidayKindNational},
+			},
+			time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local): {{Name: "元日", Kind: HolidayKindNational}},
+		},
+		lastHoliday: time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want []Holiday
+	}{
+		{name: "範囲内の休日を日付順に返す",
+			from: time.Date(2021, 1, 1, 10, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local),
+			want: []Holiday{
+				{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational},
+				{Date: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local), Name: "こどもの日", Kind: HolidayKindNational},
+				{Date: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local), Name: "システム障害", Market: MarketOSEDerivatives, Source: "counterparty.ics"},
+				{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日", Kind: HolidayKindExchange},
+			}},
+		{name: "範囲内になければ空", from: time.Date(2021, 6, 1, 0, 0, 0, 0, time.Local), to: time.Date(2021, 6, 30, 0, 0, 0, 0, time.Local), want: []Holiday{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := bd.Holidays(test.from, test.to)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_NewBusinessDayFromHolidays(t *testing.T) {
+	t.Parallel()
+	got := NewBusinessDayFromHolidays([]Holiday{
+		{Date: time.Date(2021, 12, 31, 10, 0, 0, 0, time.Local), Name: "休業日"},
+		{Date: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local), Name: "こどもの日", Kind: HolidayKindNational, Source: JPXCalendarURL},
+		{Date: time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local), Name: "システム障害", Market: MarketOSEDerivatives, Source: "counterparty.ics"},
+	}, time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local))
+
+	wantHolidays := []Holiday{
+		{Date: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local), Name: "こどもの日", Kind: HolidayKindNational, Source: JPXCalendarURL},
+		{Date: time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local), Name: "システム障害", Market: MarketOSEDerivatives, Source: "counterparty.ics"},
+		{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日"},
+	}
+	wantLastHoliday := time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)
+	wantLastUpdateDate := time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local)
+	gotHolidays := got.Holidays(time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local))
+	if !reflect.DeepEqual(wantHolidays, gotHolidays) || !reflect.DeepEqual(wantLastHoliday, got.LastHoliday()) || !reflect.DeepEqual(wantLastUpdateDate, got.LastUpdateDate()) {
+		t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), wantHolidays, wantLastHoliday, wantLastUpdateDate, gotHolidays, got.LastHoliday(), got.LastUpdateDate())
+	}
+
+	// 市場を指定した休日はその市場だけを休みにする
+	d := time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)
+	want := []bool{true, true, false, true}
+	gotTradingDays := []bool{
+		got.IsBusinessDay(d),
+		NewMarketCalendar(MarketTSECash, got).IsTradingDay(d),
+		NewMarketCalendar(MarketOSEDerivatives, got).IsTradingDay(d),
+		NewMarketCalendar(MarketTOCOM, got).IsTradingDay(d),
+	}
+	if !reflect.DeepEqual(want, gotTradingDays) || got.IsDerivativesTradingDay(d) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), want, gotTradingDays, got.IsDerivativesTradingDay(d))
+	}
+}
+
 func Test_businessDay_Refresh_OK(t *testing.T) {
 	t.Parallel()
 	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -252,8 +374,17 @@ func Test_businessDay_Refresh_OK(t *testing.T) {
 	wantLastHoliday := time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local)
 	wantLastUpdateDate := time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local)
 
-	if !reflect.DeepEqual(wantHoliday, bd.holidays) || !reflect.DeepEqual(wantLastHoliday, bd.lastHoliday) || !reflect.DeepEqual(wantLastUpdateDate, bd.lastUpdateDate) {
-		t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), wantHoliday, wantLastHoliday, wantLastUpdateDate, bd.holidays, bd.lastHoliday, bd.lastUpdateDate)
+	gotHoliday := map[time.Time]string{}
+	for d, hs := range bd.holidays {
+		for _, h := range hs {
+			gotHoliday[d] = h.Name
+			if !h.Date.Equal(d) || h.Kind != holidayKind(h.Name) || h.Market != MarketUnspecified || h.Source != serv.URL {
+				t.Errorf("%s error: holiday is not kept as parsed: %+v\n", t.Name(), h)
+			}
+		}
+	}
+	if !reflect.DeepEqual(wantHoliday, gotHoliday) || !reflect.DeepEqual(wantLastHoliday, bd.lastHoliday) || !reflect.DeepEqual(wantLastUpdateDate, bd.lastUpdateDate) {
+		t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), wantHoliday, wantLastHoliday, wantLastUpdateDate, gotHoliday, bd.lastHoliday, bd.lastUpdateDate)
 	}
 }
 
@@ -0,0 +1,144 @@
+package jpx_business_day
+
+import "time"
+
+// Calendar - 営業日を判定するカレンダー
+// BusinessDayもCalendarとして使える
+type Calendar interface {
+	IsBusinessDay(target time.Time) bool
+	IsHoliday(target time.Time) bool
+	AddBusinessDays(target time.Time, n int) time.Time
+}
+
+// Holiday - 休日
+type Holiday struct {
+	Date   time.Time
+	Name   string
+	Kind   HolidayKind
+	Market Market // 休日になる市場 未指定なら全ての市場
+	Source string // 休日の取得元
+}
+
+// HolidayKind - 休日の種類
+type HolidayKind int
+
+const (
+	HolidayKindUnspecified HolidayKind = iota
+	HolidayKindNational                // 国民の祝日、振替休日など祝日法による休日
+	HolidayKindExchange                // 年末年始など取引所が独自に定める休業日
+)
+
+func (k HolidayKind) String() string {
+	switch k {
+	case HolidayKindNational:
+		return "祝日"
+	case HolidayKindExchange:
+		return "休業日"
+	}
+	return "未指定"
+}
+
+// holidayKind - JPXの休日一覧の名称から休日の種類を判定する
+// 祝日法によらない休日は休業日と書かれている
+func holidayKind(name string) HolidayKind {
+	if name == "休業日" {
+		return HolidayKindExchange
+	}
+	return HolidayKindNational
+}
+
+// NewStaticCalendar - 土曜日、日曜日とholidaysを休日にするカレンダー
+func NewStaticCalendar(holidays []Holiday) Calendar {
+	c := &staticCalendar{holidays: map[time.Time]string{}}
+	for _, h := range holidays {
+		c.holidays[time.Date(h.Date.Year(), h.Date.Month(), h.Date.Day(), 0, 0, 0, 0, time.Local)] = h.Name
+	}
+	return c
+}
+
+type staticCalendar struct {
+	holidays map[time.Time]string
+}
+
+// IsBusinessDay - 営業日かどうか
+func (c *staticCalendar) IsBusinessDay(target time.Time) bool {
+	return !c.IsHoliday(target)
+}
+
+// IsHoliday - 休日かどうか
+func (c *staticCalendar) IsHoliday(target time.Time) bool {
+	if target.Weekday() == time.Saturday || target.Weekday() == time.Sunday {
+		return true
+	}
+	_, ok := c.holidays[time.Date(target.Year(), target.Month(), target.Day(), 0, 0, 0, 0, time.Local)]
+	return ok
+}
+
+// AddBusinessDays - targetからn営業日後の日付
+// nが負ならn営業日前、0ならtargetの日付を返す
+func (c *staticCalendar) AddBusinessDays(target time.Time, n int) time.Time {
+	return addBusinessDaysBy(c.IsBusinessDay, target, n)
+}
+
+// Union - いずれかのカレンダーで休日なら休日になるカレンダー
+// すべてのカレンダーで営業日の日だけが営業日になるので、複数の市場で共通の営業日を求めるときに使う
+// カレンダーがなければすべての日が営業日になる
+func Union(calendars ...Calendar) Calendar {
+	return &compositeCalendar{calendars: calendars, all: false}
+}
+
+// Intersection - すべてのカレンダーで休日のときだけ休日になるカレンダー
+// いずれかのカレンダーで営業日なら営業日になる
+// カレンダーがなければすべての日が営業日になる
+func Intersection(calendars ...Calendar) Calendar {
+	return &compositeCalendar{calendars: calendars, all: true}
+}
+
+type compositeCalendar struct {
+	calendars []Calendar
+	all       bool // trueならすべてのカレンダーで休日のときに休日
+}
+
+// IsBusinessDay - 営業日かどうか
+func (c *compositeCalendar) IsBusinessDay(target time.Time) bool {
+	return !c.IsHoliday(target)
+}
+
+// IsHoliday - 休日かどうか
+func (c *compositeCalendar) IsHoliday(target time.Time) bool {
+	if len(c.calendars) == 0 {
+		return false
+	}
+	for _, cal := range c.calendars {
+		if cal.IsHoliday(target) != c.all {
+			return !c.all
+		}
+	}
+	return c.all
+}
+
+// AddBusinessDays - targetからn営業日後の日付
+// nが負ならn営業日前、0ならtargetの日付を返す
+// 1営業日進むのにsearchLimitDays日を超えたらゼロ値を返す
+func (c *compositeCalendar) AddBusinessDays(target time.Time, n int) time.Time {
+	return addBusinessDaysBy(c.IsBusinessDay, target, n)
+}
+
+// addBusinessDaysBy - isBusinessDayを使ってtargetからn営業日後の日付を1日ずつ探す
+// 1営業日進むのにsearchLimitDays日を超えたらゼロ値を返す
+func addBusinessDaysBy(isBusinessDay func(time.Time) bool, target time.Time, n int) time.Time {
+	d := time.Date(target.Year(), target.Month(), target.Day(), 0, 0, 0, 0, time.Local)
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for ; n > 0; n-- {
+		i := 0
+		for d = d.AddDate(0, 0, step); !isBusinessDay(d); d = d.AddDate(0, 0, step) {
+			if i++; i >= searchLimitDays {
+				return time.Time{}
+			}
+		}
+	}
+	return d
+}
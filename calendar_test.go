@@ -0,0 +1,134 @@
+package jpx_business_day
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_staticCalendar_IsHoliday(t *testing.T) {
+	t.Parallel()
+	cal := NewStaticCalendar([]Holiday{{Date: time.Date(2021, 7, 5, 12, 0, 0, 0, time.Local), Name: "Independence Day"}})
+	tests := []struct {
+		name string
+		arg  time.Time
+		want bool
+	}{
+		{name: "holidaysにあればtrue", arg: time.Date(2021, 7, 5, 0, 0, 0, 0, time.Local), want: true},
+		{name: "holidaysになければfalse", arg: time.Date(2021, 7, 6, 0, 0, 0, 0, time.Local), want: false},
+		{name: "土曜日はtrue", arg: time.Date(2021, 7, 3, 0, 0, 0, 0, time.Local), want: true},
+		{name: "日曜日はtrue", arg: time.Date(2021, 7, 4, 0, 0, 0, 0, time.Local), want: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := cal.IsHoliday(test.arg)
+			if !reflect.DeepEqual(test.want, got) || got == cal.IsBusinessDay(test.arg) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_compositeCalendar_IsHoliday(t *testing.T) {
+	t.Parallel()
+	tokyo := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 7, 22, 0, 0, 0, 0, time.Local): {{Name: "海の日"}},
+		},
+		lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)}
+	ny := NewStaticCalendar([]Holiday{{Date: time.Date(2021, 7, 5, 0, 0, 0, 0, time.Local), Name: "Independence Day"}})
+	tests := []struct {
+		name     string
+		calendar Calendar
+		arg      time.Time
+		want     bool
+	}{
+		{name: "Unionは片方だけの休日でもtrue", calendar: Union(tokyo, ny), arg: time.Date(2021, 7, 5, 0, 0, 0, 0, time.Local), want: true},
+		{name: "Unionはもう片方だけの休日でもtrue", calendar: Union(tokyo, ny), arg: time.Date(2021, 7, 22, 0, 0, 0, 0, time.Local), want: true},
+		{name: "Unionは両方営業日ならfalse", calendar: Union(tokyo, ny), arg: time.Date(2021, 7, 6, 0, 0, 0, 0, time.Local), want: false},
+		{name: "Intersectionは片方だけの休日ならfalse", calendar: Intersection(tokyo, ny), arg: time.Date(2021, 7, 5, 0, 0, 0, 0, time.Local), want: false},
+		{name: "Intersectionは両方休日ならtrue", calendar: Intersection(tokyo, ny), arg: time.Date(2021, 7, 4, 0, 0, 0, 0, time.Local), want: true},
+		{name: "カレンダーがなければfalse", calendar: Union(), arg: time.Date(2021, 7, 4, 0, 0, 0, 0, time.Local), want: false},
+		{name: "入れ子にもできる", calendar: Union(Intersection(tokyo, ny), ny), arg: time.Date(2021, 7, 5, 0, 0, 0, 0, time.Local), want: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := test.calendar.IsHoliday(test.arg)
+			if !reflect.DeepEqual(test.want, got) || got == test.calendar.IsBusinessDay(test.arg) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_compositeCalendar_AddBusinessDays(t *testing.T) {
+	t.Parallel()
+	tokyo := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 7, 22, 0, 0, 0, 0, time.Local): {{Name: "海の日"}},
+			time.Date(2021, 7, 23, 0, 0, 0, 0, time.Local): {{Name: "スポーツの日"}},
+		},
+		lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)}
+	other := NewStaticCalendar([]Holiday{{Date: time.Date(2021, 7, 26, 0, 0, 0, 0, time.Local), Name: "休日"}})
+	tests := []struct {
+		name     string
+		calendar Calendar
+		target   time.Time
+		n        int
+		want     time.Time
+	}{
+		{name: "両方の休日を飛ばして進む",
+			calendar: Union(tokyo, other),
+			target:   time.Date(2021, 7, 21, 0, 0, 0, 0, time.Local),
+			n:        2,
+			want:     time.Date(2021, 7, 28, 0, 0, 0, 0, time.Local)},
+		{name: "両方の休日を飛ばして戻る",
+			calendar: Union(tokyo, other),
+			target:   time.Date(2021, 7, 27, 0, 0, 0, 0, time.Local),
+			n:        -1,
+			want:     time.Date(2021, 7, 21, 0, 0, 0, 0, time.Local)},
+		{name: "Intersectionはどちらかの営業日で進む",
+			calendar: Intersection(tokyo, other),
+			target:   time.Date(2021, 7, 21, 0, 0, 0, 0, time.Local),
+			n:        2,
+			want:     time.Date(2021, 7, 23, 0, 0, 0, 0, time.Local)},
+		{name: "0ならtargetの日付",
+			calendar: Union(tokyo, other),
+			target:   time.Date(2021, 7, 24, 9, 0, 0, 0, time.Local),
+			n:        0,
+			want:     time.Date(2021, 7, 24, 0, 0, 0, 0, time.Local)},
+		{name: "Intersectionは片方がすべて休日でももう片方の営業日で進む",
+			calendar: Intersection(NewStaticCalendar(nil), &alwaysHolidayCalendar{}),
+			target:   time.Date(2021, 7, 24, 0, 0, 0, 0, time.Local),
+			n:        1,
+			want:     time.Date(2021, 7, 26, 0, 0, 0, 0, time.Local)},
+		{name: "すべて休日ならゼロ値",
+			calendar: Union(&alwaysHolidayCalendar{}),
+			target:   time.Date(2021, 7, 24, 0, 0, 0, 0, time.Local),
+			n:        1,
+			want:     time.Time{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := test.calendar.AddBusinessDays(test.target, test.n)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+type alwaysHolidayCalendar struct{}
+
+func (c *alwaysHolidayCalendar) IsBusinessDay(time.Time) bool             { return false }
+func (c *alwaysHolidayCalendar) IsHoliday(time.Time) bool                 { return true }
+func (c *alwaysHolidayCalendar) AddBusinessDays(time.Time, int) time.Time { return time.Time{} }
@@ -0,0 +1,78 @@
+package jpx_business_day
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock - 現在時刻を返す時計
+// 今日を基準にする判定はこの時計を使う
+type Clock interface {
+	Now() time.Time
+}
+
+// NewFakeClock - nowを返し続ける、テスト用の時計
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// FakeClock - SetかAdvanceで進めるまで同じ時刻を返すテスト用の時計
+type FakeClock struct {
+	now time.Time
+	mtx sync.Mutex
+}
+
+// Now - 現在時刻
+func (c *FakeClock) Now() time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.now
+}
+
+// Set - 現在時刻をnowにする
+func (c *FakeClock) Set(now time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.now = now
+}
+
+// Advance - 現在時刻をdだけ進める
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// now - 現在時刻
+// 時計が設定されていなければtime.Nowを使う
+func (b *businessDay) now() time.Time {
+	if b.clock == nil {
+		return time.Now()
+	}
+	return b.clock.Now()
+}
+
+// Today - 今日の日付
+// IsOpenNowと同じく、取引所の時間帯での日付にする
+func (b *businessDay) Today() time.Time {
+	now := b.now().In(exchangeLocation)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// IsTodayBusinessDay - 今日が営業日かどうか
+func (b *businessDay) IsTodayBusinessDay() bool {
+	return b.IsBusinessDay(b.Today())
+}
+
+// IsOpenNow - 現在が東京証券取引所の現物市場の立会時間中かどうか
+func (b *businessDay) IsOpenNow() bool {
+	return b.IsMarketOpen(b.now())
+}
+
+// NextBusinessDayFromNow - 今日の翌営業日
+func (b *businessDay) NextBusinessDayFromNow() time.Time {
+	return b.AddBusinessDays(b.Today(), 1)
+}
@@ -0,0 +1,101 @@
+package jpx_business_day
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_FakeClock(t *testing.T) {
+	t.Parallel()
+	clock := NewFakeClock(time.Date(2021, 5, 6, 9, 0, 0, 0, time.Local))
+	if want, got := time.Date(2021, 5, 6, 9, 0, 0, 0, time.Local), clock.Now(); !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+	clock.Advance(90 * time.Minute)
+	if want, got := time.Date(2021, 5, 6, 10, 30, 0, 0, time.Local), clock.Now(); !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+	clock.Set(time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local))
+	if want, got := time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local), clock.Now(); !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_businessDay_now(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{}
+	before := time.Now()
+	got := bd.now()
+	if got.Before(before) || got.After(time.Now()) {
+		t.Errorf("%s error: time.Now is not used: %+v\n", t.Name(), got)
+	}
+}
+
+func Test_businessDay_Today(t *testing.T) {
+	t.Parallel()
+	holidays := []Holiday{
+		{Date: time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local), Name: "憲法記念日"},
+		{Date: time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local), Name: "みどりの日"},
+		{Date: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local), Name: "こどもの日"},
+	}
+	tests := []struct {
+		name                   string
+		now                    time.Time
+		wantToday              time.Time
+		wantIsTodayBusinessDay bool
+		wantIsOpenNow          bool
+		wantNextBusinessDay    time.Time
+	}{
+		{name: "営業日の立会時間中",
+			now:                    time.Date(2021, 4, 30, 10, 0, 0, 0, exchangeLocation),
+			wantToday:              time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			wantIsTodayBusinessDay: true,
+			wantIsOpenNow:          true,
+			wantNextBusinessDay:    time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)},
+		{name: "営業日の立会時間外",
+			now:                    time.Date(2021, 5, 6, 16, 0, 0, 0, exchangeLocation),
+			wantToday:              time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local),
+			wantIsTodayBusinessDay: true,
+			wantIsOpenNow:          false,
+			wantNextBusinessDay:    time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local)},
+		{name: "休日",
+			now:                    time.Date(2021, 5, 4, 10, 0, 0, 0, exchangeLocation),
+			wantToday:              time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local),
+			wantIsTodayBusinessDay: false,
+			wantIsOpenNow:          false,
+			wantNextBusinessDay:    time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)},
+		{name: "UTCではまだ前日でも取引所の時間帯の日付にする",
+			now:                    time.Date(2021, 5, 5, 23, 0, 0, 0, time.UTC),
+			wantToday:              time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local),
+			wantIsTodayBusinessDay: true,
+			wantIsOpenNow:          false,
+			wantNextBusinessDay:    time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local)},
+		{name: "UTCの立会時間中",
+			now:                    time.Date(2021, 5, 6, 1, 0, 0, 0, time.UTC),
+			wantToday:              time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local),
+			wantIsTodayBusinessDay: true,
+			wantIsOpenNow:          true,
+			wantNextBusinessDay:    time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			bd := NewBusinessDayFromHolidays(holidays, time.Time{}, WithClock(NewFakeClock(test.now)))
+			gotToday := bd.Today()
+			gotIsTodayBusinessDay := bd.IsTodayBusinessDay()
+			gotIsOpenNow := bd.IsOpenNow()
+			gotNextBusinessDay := bd.NextBusinessDayFromNow()
+			if !reflect.DeepEqual(test.wantToday, gotToday) ||
+				!reflect.DeepEqual(test.wantIsTodayBusinessDay, gotIsTodayBusinessDay) ||
+				!reflect.DeepEqual(test.wantIsOpenNow, gotIsOpenNow) ||
+				!reflect.DeepEqual(test.wantNextBusinessDay, gotNextBusinessDay) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(),
+					test.wantToday, test.wantIsTodayBusinessDay, test.wantIsOpenNow, test.wantNextBusinessDay,
+					gotToday, gotIsTodayBusinessDay, gotIsOpenNow, gotNextBusinessDay)
+			}
+		})
+	}
+}
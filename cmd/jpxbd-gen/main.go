@@ -0,0 +1,174 @@
+// jpxbd-gen - JPXのページの休日をGoのソースに書き出すコマンド
+//
+//	jpxbd-gen [-url URL | -file PATH] [-o PATH] [-package NAME] [-name NAME]
+//
+// go generateから使うと、ビルド時に決まった休日だけで動く営業日情報を作れる
+//
+//	//go:generate go run gitlab.com/tsuchinaga/jpx-business-day/cmd/jpxbd-gen -file jpx.html -o jpx_holidays.go
+//
+// 生成したファイルには休日の一覧と、それを使うNewBusinessDayFromHolidaysの呼び出しが含まれる
+// -fileを指定すると、保存しておいたページのHTMLからネットワークに接続せずに生成する
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"net/http"
+	"os"
+	"text/template"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+const (
+	exitOK    = 0
+	exitError = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run - コマンドを実行して終了コードを返す
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("jpxbd-gen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	url := fs.String("url", jbd.JPXCalendarURL, "page of holidays")
+	file := fs.String("file", "", "saved HTML of the page, used instead of -url")
+	out := fs.String("o", "", "output file, stdout if empty")
+	pkg := fs.String("package", defaultPackage(), "package name of the output")
+	name := fs.String("name", "jpxBusinessDay", "name of the generated function")
+	fs.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "usage: jpxbd-gen [flags]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	source := *url
+	if *file != "" {
+		source = *file
+	}
+	update, holidays, err := load(ctx, *url, *file)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "jpxbd-gen: %v\n", err)
+		return exitError
+	}
+
+	src, err := generate(params{Source: source, Package: *pkg, Name: *name, LastUpdateDate: update, Holidays: holidays})
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "jpxbd-gen: %v\n", err)
+		return exitError
+	}
+
+	if *out == "" {
+		_, err = stdout.Write(src)
+	} else {
+		err = os.WriteFile(*out, src, 0o644)
+	}
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "jpxbd-gen: %v\n", err)
+		return exitError
+	}
+	return exitOK
+}
+
+// defaultPackage - go generateから呼ばれたときはそのパッケージ名、それ以外はmain
+func defaultPackage() string {
+	if pkg := os.Getenv("GOPACKAGE"); pkg != "" {
+		return pkg
+	}
+	return "main"
+}
+
+// load - fileがあればfileから、なければurlからページを読み取る
+func load(ctx context.Context, url, file string) (time.Time, []jbd.Holiday, error) {
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return time.Time{}, nil, err
+		}
+		defer f.Close()
+		return jbd.ParsePage(f)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return time.Time{}, nil, fmt.Errorf("status is %d: %w", res.StatusCode, jbd.NotOKStatusError)
+	}
+	return jbd.ParsePage(res.Body)
+}
+
+// params - 生成するソースに埋め込む値
+type params struct {
+	Source         string
+	Package        string
+	Name           string
+	LastUpdateDate time.Time
+	Holidays       []jbd.Holiday
+}
+
+var tmpl = template.Must(template.New("").Funcs(template.FuncMap{
+	"date": func(t time.Time) string {
+		return fmt.Sprintf("time.Date(%d, %d, %d, 0, 0, 0, 0, time.Local)", t.Year(), t.Month(), t.Day())
+	},
+	"kind": func(k jbd.HolidayKind) string {
+		switch k {
+		case jbd.HolidayKindNational:
+			return "jbd.HolidayKindNational"
+		case jbd.HolidayKindExchange:
+			return "jbd.HolidayKindExchange"
+		}
+		return "jbd.HolidayKindUnspecified"
+	},
+}).Parse(`// Code generated by jpxbd-gen from {{.Source}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+// {{.Name}}LastUpdateDate - 休日を取得したJPXのページの更新日
+var {{.Name}}LastUpdateDate = {{date .LastUpdateDate}}
+
+// {{.Name}}Holidays - JPXのページから取得した休日
+var {{.Name}}Holidays = []jbd.Holiday{
+{{- range .Holidays}}
+	{Date: {{date .Date}}, Name: {{printf "%q" .Name}}, Kind: {{kind .Kind}}},
+{{- end}}
+}
+
+// {{.Name}} - 生成した休日だけを使う営業日情報
+// Refreshしなければネットワークに接続しない
+func {{.Name}}() jbd.BusinessDay {
+	return jbd.NewBusinessDayFromHolidays({{.Name}}Holidays, {{.Name}}LastUpdateDate)
+}
+`))
+
+// generate - 休日の一覧をgofmtしたGoのソースにする
+func generate(p params) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := tmpl.Execute(buf, p); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
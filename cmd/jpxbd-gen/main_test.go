@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testPage = `<li>2021/01/07 更新</li>
+<tr><td class="a-center">2021/12/31（金）</td><td class="a-center">休業日</td></tr>
+<tr><td class="a-center">2022/01/01（土）</td><td class="a-center">元日</td></tr>
+`
+
+const testWant = `// Code generated by jpxbd-gen from %s; DO NOT EDIT.
+
+package holidays
+
+import (
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+// staticLastUpdateDate - 休日を取得したJPXのページの更新日
+var staticLastUpdateDate = time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local)
+
+// staticHolidays - JPXのページから取得した休日
+var staticHolidays = []jbd.Holiday{
+	{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日", Kind: jbd.HolidayKindExchange},
+	{Date: time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: jbd.HolidayKindNational},
+}
+
+// static - 生成した休日だけを使う営業日情報
+// Refreshしなければネットワークに接続しない
+func static() jbd.BusinessDay {
+	return jbd.NewBusinessDayFromHolidays(staticHolidays, staticLastUpdateDate)
+}
+`
+
+func Test_run(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	page := filepath.Join(dir, "jpx.html")
+	if err := os.WriteFile(page, []byte(testPage), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(testPage))
+	}))
+	t.Cleanup(serv.Close)
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		wantOut  string
+	}{
+		{name: "保存したページから生成できる",
+			args:     []string{"-file", page, "-package", "holidays", "-name", "static"},
+			wantCode: exitOK,
+			wantOut:  fmt.Sprintf(testWant, page)},
+		{name: "URLから生成できる",
+			args:     []string{"-url", serv.URL + "/", "-package", "holidays", "-name", "static"},
+			wantCode: exitOK,
+			wantOut:  fmt.Sprintf(testWant, serv.URL+"/")},
+		{name: "ページが取得できなければエラー", args: []string{"-url", serv.URL + "/notfound"}, wantCode: exitError, wantOut: ""},
+		{name: "ファイルがなければエラー", args: []string{"-file", filepath.Join(dir, "notfound.html")}, wantCode: exitError, wantOut: ""},
+		{name: "知らないフラグはエラー", args: []string{"-unknown"}, wantCode: exitError, wantOut: ""},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			got := run(test.args, stdout, stderr)
+			if !reflect.DeepEqual(test.wantCode, got) || !reflect.DeepEqual(test.wantOut, stdout.String()) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), test.wantCode, test.wantOut, got, stdout.String(), stderr.String())
+			}
+		})
+	}
+}
+
+func Test_run_Output(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	page := filepath.Join(dir, "jpx.html")
+	if err := os.WriteFile(page, []byte(testPage), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(dir, "jpx_holidays.go")
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if code := run([]string{"-file", page, "-o", out, "-package", "holidays", "-name", "static"}, stdout, stderr); code != exitOK {
+		t.Fatalf("%s error: %d, %s\n", t.Name(), code, stderr.String())
+	}
+	got, err := os.ReadFile(out)
+	if want := fmt.Sprintf(testWant, page); !reflect.DeepEqual(want, string(got)) || err != nil || stdout.Len() != 0 {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v, %+v\n", t.Name(), want, string(got), err, stdout.String())
+	}
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+const dateLayout = "2006-01-02"
+
+// newHandler - bdの営業日情報を返すJSON APIのハンドラ
+// 更新状況はbdに渡したmetricsから返す
+func newHandler(bd jbd.BusinessDay, metrics *jbd.RefreshMetrics) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/business-day", func(w http.ResponseWriter, r *http.Request) {
+		d, err := dateParam(bd, r, "date")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		if err := covered(bd, d); err != nil {
+			writeError(w, http.StatusServiceUnavailable, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"date": d.Format(dateLayout), "businessDay": bd.IsBusinessDay(d)})
+	})
+	mux.HandleFunc("/v1/next", func(w http.ResponseWriter, r *http.Request) {
+		d, err := dateParam(bd, r, "date")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		n := 1
+		if s := r.URL.Query().Get("n"); s != "" {
+			if n, err = strconv.Atoi(s); err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Errorf("n is not a number: %w", err))
+				return
+			}
+		}
+		if err := covered(bd, d); err != nil {
+			writeError(w, http.StatusServiceUnavailable, err)
+			return
+		}
+		next := bd.AddBusinessDays(d, n)
+		if err := covered(bd, next); err != nil {
+			writeError(w, http.StatusServiceUnavailable, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"date": next.Format(dateLayout)})
+	})
+	mux.HandleFunc("/v1/holidays", func(w http.ResponseWriter, r *http.Request) {
+		from, err := dateParam(bd, r, "from")
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+		to := bd.LastHoliday()
+		if r.URL.Query().Get("to") != "" {
+			if to, err = dateParam(bd, r, "to"); err != nil {
+				writeError(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+		holidays := []map[string]interface{}{}
+		for _, h := range bd.Holidays(from, to) {
+			holidays = append(holidays, map[string]interface{}{"date": h.Date.Format(dateLayout), "name": h.Name, "kind": h.Kind.String()})
+		}
+		writeJSON(w, http.StatusOK, holidays)
+	})
+	mux.HandleFunc("/v1/holidays.ics", func(w http.ResponseWriter, r *http.Request) {
+		from, to := time.Time{}, bd.LastHoliday()
+		var err error
+		if r.URL.Query().Get("from") != "" {
+			if from, err = dateParam(bd, r, "from"); err != nil {
+				writeError(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+		if r.URL.Query().Get("to") != "" {
+			if to, err = dateParam(bd, r, "to"); err != nil {
+				writeError(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		_ = jbd.WriteICS(w, bd.Holidays(from, to), bd.LastUpdateDate())
+	})
+	mux.HandleFunc("/v1/status", func(w http.ResponseWriter, r *http.Request) {
+		lastRefresh, lastError := metrics.LastRefresh()
+		res := map[string]interface{}{
+			"lastUpdateDate": formatDate(bd.LastUpdateDate()),
+			"lastHoliday":    formatDate(bd.LastHoliday()),
+			"lastRefresh":    nil,
+			"lastError":      nil,
+		}
+		if !lastRefresh.IsZero() {
+			res["lastRefresh"] = lastRefresh.Format(time.RFC3339)
+		}
+		if lastError != nil {
+			res["lastError"] = lastError.Error()
+		}
+		writeJSON(w, http.StatusOK, res)
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s is not allowed", r.Method))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// dateParam - クエリパラメータの日付
+// 省略されていれば今日を返す
+func dateParam(bd jbd.BusinessDay, r *http.Request, name string) (time.Time, error) {
+	s := r.URL.Query().Get(name)
+	if s == "" {
+		return bd.Today(), nil
+	}
+	d, err := time.ParseInLocation(dateLayout, strings.ReplaceAll(s, "/", "-"), time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s is not a date: %w", name, err)
+	}
+	return d, nil
+}
+
+// covered - dが取得済みの休日情報の範囲内かどうか
+// 休日情報を一度も取得できていないか、dが最後の休日の年末より後ならエラーを返す
+func covered(bd jbd.BusinessDay, d time.Time) error {
+	if bd.LastUpdateDate().IsZero() {
+		return fmt.Errorf("holidays are not loaded yet")
+	}
+	if until := time.Date(bd.LastHoliday().Year(), 12, 31, 0, 0, 0, 0, time.Local); d.After(until) {
+		return fmt.Errorf("%s is after the holidays coverage %s", d.Format(dateLayout), until.Format(dateLayout))
+	}
+	return nil
+}
+
+// formatDate - ゼロ値ならnil、そうでなければ日付の文字列
+func formatDate(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t.Format(dateLayout)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]interface{}{"error": err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+func Test_newHandler(t *testing.T) {
+	t.Parallel()
+	bd := jbd.NewBusinessDayFromHolidays([]jbd.Holiday{
+		{Date: time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local), Name: "憲法記念日", Kind: jbd.HolidayKindNational},
+		{Date: time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local), Name: "みどりの日", Kind: jbd.HolidayKindNational},
+		{Date: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local), Name: "こどもの日", Kind: jbd.HolidayKindNational},
+		{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日", Kind: jbd.HolidayKindExchange},
+	}, time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local))
+	metrics := jbd.NewRefreshMetrics(nil)
+	metrics.ObserveRefresh(jbd.RefreshResult{At: time.Date(2021, 1, 8, 9, 0, 0, 0, time.UTC), Err: errors.New("not ok status error")})
+	serv := httptest.NewServer(newHandler(bd, metrics))
+	t.Cleanup(serv.Close)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantStatus int
+		wantBody   string // 空ならbodyは確認しない
+	}{
+		{name: "営業日", method: http.MethodGet, path: "/v1/business-day?date=2021-05-06", wantStatus: http.StatusOK,
+			wantBody: `{"businessDay":true,"date":"2021-05-06"}` + "\n"},
+		{name: "休業日", method: http.MethodGet, path: "/v1/business-day?date=2021/05/05", wantStatus: http.StatusOK,
+			wantBody: `{"businessDay":false,"date":"2021-05-05"}` + "\n"},
+		{name: "不正な日付は400", method: http.MethodGet, path: "/v1/business-day?date=20210505", wantStatus: http.StatusBadRequest,
+			wantBody: ""},
+		{name: "休日情報の範囲外は503", method: http.MethodGet, path: "/v1/business-day?date=2022-01-04", wantStatus: http.StatusServiceUnavailable,
+			wantBody: `{"error":"2022-01-04 is after the holidays coverage 2021-12-31"}` + "\n"},
+		{name: "翌営業日", method: http.MethodGet, path: "/v1/next?date=2021-04-30", wantStatus: http.StatusOK,
+			wantBody: `{"date":"2021-05-06"}` + "\n"},
+		{name: "n営業日前", method: http.MethodGet, path: "/v1/next?date=2021-05-06&n=-2", wantStatus: http.StatusOK,
+			wantBody: `{"date":"2021-04-29"}` + "\n"},
+		{name: "n営業日後が休日情報の範囲外なら503", method: http.MethodGet, path: "/v1/next?date=2021-12-30&n=1", wantStatus: http.StatusServiceUnavailable,
+			wantBody: `{"error":"2022-01-03 is after the holidays coverage 2021-12-31"}` + "\n"},
+		{name: "nが数値でなければ400", method: http.MethodGet, path: "/v1/next?n=a", wantStatus: http.StatusBadRequest,
+			wantBody: ""},
+		{name: "期間内の休日", method: http.MethodGet, path: "/v1/holidays?from=2021-05-04&to=2021-05-31", wantStatus: http.StatusOK,
+			wantBody: `[{"date":"2021-05-04","kind":"祝日","name":"みどりの日"},{"date":"2021-05-05","kind":"祝日","name":"こどもの日"}]` + "\n"},
+		{name: "toを省略すると最後の休日まで", method: http.MethodGet, path: "/v1/holidays?from=2021-05-05", wantStatus: http.StatusOK,
+			wantBody: `[{"date":"2021-05-05","kind":"祝日","name":"こどもの日"},{"date":"2021-12-31","kind":"休業日","name":"休業日"}]` + "\n"},
+		{name: "期間内の休日のiCalendar", method: http.MethodGet, path: "/v1/holidays.ics?from=2021-12-01", wantStatus: http.StatusOK,
+			wantBody: "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//tsuchinaga//jpx-business-day//JA\r\nCALSCALE:GREGORIAN\r\nMETHOD:PUBLISH\r\nX-WR-CALNAME:JPX休業日\r\n" +
+				"BEGIN:VEVENT\r\nUID:20211231@jpx-business-day\r\nDTSTAMP:" + time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local).UTC().Format("20060102T150405Z") + "\r\n" +
+				"DTSTART;VALUE=DATE:20211231\r\nDTEND;VALUE=DATE:20220101\r\nSUMMARY:休業日\r\nCATEGORIES:休業日\r\nTRANSP:TRANSPARENT\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"},
+		{name: "更新状況", method: http.MethodGet, path: "/v1/status", wantStatus: http.StatusOK,
+			wantBody: `{"lastError":"not ok status error","lastHoliday":"2021-12-31","lastRefresh":"2021-01-08T09:00:00Z","lastUpdateDate":"2021-01-07"}` + "\n"},
+		{name: "GET以外は405", method: http.MethodPost, path: "/v1/status", wantStatus: http.StatusMethodNotAllowed,
+			wantBody: `{"error":"method POST is not allowed"}` + "\n"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			req, err := http.NewRequest(test.method, serv.URL+test.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			body, _ := io.ReadAll(res.Body)
+			if !reflect.DeepEqual(test.wantStatus, res.StatusCode) || (test.wantBody != "" && !reflect.DeepEqual(test.wantBody, string(body))) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantStatus, test.wantBody, res.StatusCode, string(body))
+			}
+		})
+	}
+}
+
+func Test_newHandler_NotLoaded(t *testing.T) {
+	t.Parallel()
+	serv := httptest.NewServer(newHandler(jbd.NewBusinessDay(), jbd.NewRefreshMetrics(nil)))
+	t.Cleanup(serv.Close)
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "営業日", path: "/v1/business-day?date=2021-05-06"},
+		{name: "n営業日後", path: "/v1/next?date=2021-04-30"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			res, err := http.Get(serv.URL + test.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			body, _ := io.ReadAll(res.Body)
+			want := `{"error":"holidays are not loaded yet"}` + "\n"
+			if !reflect.DeepEqual(http.StatusServiceUnavailable, res.StatusCode) || !reflect.DeepEqual(want, string(body)) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), http.StatusServiceUnavailable, want, res.StatusCode, string(body))
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// jpxbd-server - JPXの営業日をJSONで返すHTTPサーバー
+//
+//	jpxbd-server [-addr :8080] [-interval 6h]
+//
+// GET /v1/business-day?date=2006-01-02  営業日かどうか
+// GET /v1/next?date=2006-01-02&n=1      n営業日後(nが負ならn営業日前)
+// GET /v1/holidays?from=...&to=...      期間内の休日の一覧(toを省略すると最後の休日まで)
+// GET /v1/holidays.ics?from=...&to=...  期間内の休日のiCalendar(省略すると取得済みのすべての休日)
+// GET /v1/status                        休日情報の更新状況
+// GET /metrics                          Refreshの計測(Prometheusのテキスト形式)
+//
+// 日付を省略すると今日になる
+// 休日情報を取得できていないか、日付が取得済みの休日情報の範囲外なら503を返す
+// 休日情報は起動時とintervalごとにJPXのページから取得し直す
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "listen address")
+	interval := flag.Duration("interval", 6*time.Hour, "refresh interval")
+	flag.Parse()
+
+	metrics := jbd.NewRefreshMetrics(nil)
+	bd := jbd.NewBusinessDay(jbd.WithMetrics(metrics))
+	refresh := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := bd.Refresh(ctx); err != nil {
+			log.Printf("refresh failed: %v", err)
+		}
+	}
+	refresh()
+	go func() {
+		ticker := time.NewTicker(*interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			refresh()
+		}
+	}()
+
+	log.Printf("listen on %s", *addr)
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics)
+	mux.Handle("/", newHandler(bd, metrics))
+	log.Fatal(http.ListenAndServe(*addr, mux))
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+// cacheFile - キャッシュファイルに保存する休日情報
+// 休日はjbd.WriteJSONの形式のまま持つ
+type cacheFile struct {
+	LastUpdateDate string          `json:"lastUpdateDate"`
+	Holidays       json.RawMessage `json:"holidays"`
+}
+
+// cacheFormat - キャッシュファイルの休日の形式
+var cacheFormat = jbd.FormatOption{DateLayout: dateLayout}
+
+// defaultCachePath - ユーザーのキャッシュディレクトリ以下のキャッシュファイル
+func defaultCachePath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "jpxbd", "holidays.json")
+}
+
+// loadBusinessDay - 営業日情報を用意する
+// キャッシュがmaxAgeより新しいか、offlineならキャッシュを使い、そうでなければJPXのページから取得してキャッシュに保存する
+// 取得に失敗したときは古いキャッシュでも使う
+func loadBusinessDay(ctx context.Context, path string, offline bool, maxAge time.Duration, stderr io.Writer) (jbd.BusinessDay, error) {
+	var modTime time.Time
+	if path != "" {
+		if info, err := os.Stat(path); err == nil {
+			modTime = info.ModTime()
+		}
+	}
+	if !modTime.IsZero() && (offline || time.Since(modTime) < maxAge) {
+		return readCache(path)
+	}
+	if offline {
+		return nil, errors.New("offline but cache is not found")
+	}
+
+	bd := jbd.NewBusinessDay()
+	if err := bd.Refresh(ctx); err != nil {
+		if modTime.IsZero() {
+			return nil, err
+		}
+		_, _ = fmt.Fprintf(stderr, "jpxbd: refresh failed, use stale cache: %v\n", err)
+		return readCache(path)
+	}
+
+	if path != "" {
+		if err := writeCache(path, bd); err != nil {
+			_, _ = fmt.Fprintf(stderr, "jpxbd: cache is not saved: %v\n", err)
+		}
+	}
+	return bd, nil
+}
+
+// readCache - キャッシュファイルから営業日情報を作る
+func readCache(path string) (jbd.BusinessDay, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cache cacheFile
+	if err := json.Unmarshal(b, &cache); err != nil {
+		return nil, fmt.Errorf("%s is broken: %w", path, err)
+	}
+
+	update, err := time.ParseInLocation(dateLayout, cache.LastUpdateDate, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("%s is broken: %w", path, err)
+	}
+	holidays, err := jbd.ReadJSON(bytes.NewReader(cache.Holidays), cacheFormat)
+	if err != nil {
+		return nil, fmt.Errorf("%s is broken: %w", path, err)
+	}
+	return jbd.NewBusinessDayFromHolidays(holidays, update), nil
+}
+
+// writeCache - 営業日情報をキャッシュファイルに保存する
+func writeCache(path string, bd jbd.BusinessDay) error {
+	buf := &bytes.Buffer{}
+	if err := jbd.WriteJSON(buf, bd.Holidays(time.Time{}, bd.LastHoliday()), cacheFormat); err != nil {
+		return err
+	}
+	cache := cacheFile{LastUpdateDate: bd.LastUpdateDate().Format(dateLayout), Holidays: buf.Bytes()}
+	b, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0o644)
+}
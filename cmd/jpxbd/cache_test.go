@@ -0,0 +1,31 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+func Test_writeCache_readCache(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "dir", "holidays.json")
+	want := jbd.NewBusinessDayFromHolidays([]jbd.Holiday{
+		{Date: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local), Name: "こどもの日", Kind: jbd.HolidayKindNational},
+		{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日", Kind: jbd.HolidayKindExchange, Source: "https://www.jpx.co.jp/"},
+	}, time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local))
+	if err := writeCache(path, want); err != nil {
+		t.Fatalf("%s error: %+v\n", t.Name(), err)
+	}
+
+	got, err := readCache(path)
+	if err != nil {
+		t.Fatalf("%s error: %+v\n", t.Name(), err)
+	}
+	from, to := time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)
+	if !reflect.DeepEqual(want.Holidays(from, to), got.Holidays(from, to)) || !reflect.DeepEqual(want.LastUpdateDate(), got.LastUpdateDate()) {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), want.Holidays(from, to), want.LastUpdateDate(), got.Holidays(from, to), got.LastUpdateDate())
+	}
+}
@@ -0,0 +1,250 @@
+// jpxbd - JPXの営業日をシェルから確認するコマンド
+//
+//	jpxbd [-format text|json|csv] [-cache PATH] [-offline] [-max-age DURATION] COMMAND [ARGS]
+//
+// COMMAND
+//
+//	is-business-day [DATE]    営業日なら終了コード0、休業日なら1で終わる
+//	next [DATE]               翌営業日
+//	prev [DATE]               前営業日
+//	add N [DATE]              N営業日後(Nが負ならN営業日前)
+//	list -from DATE -to DATE  期間内の営業日の一覧
+//	holidays YEAR             年の休日の一覧
+//	ics [YEAR]                休日をiCalendar形式で出力(YEARを省略すると取得済みのすべての休日)
+//
+// DATEは2006-01-02か2006/01/02の形式で、省略すると今日になる
+// 休日情報はキャッシュファイルに保存し、-offlineならキャッシュだけを使う
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+const (
+	exitOK    = 0
+	exitFalse = 1
+	exitError = 2
+
+	dateLayout = "2006-01-02"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run - コマンドを実行して終了コードを返す
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("jpxbd", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "text", "output format: text, json or csv")
+	cache := fs.String("cache", defaultCachePath(), "cache file of holidays")
+	offline := fs.Bool("offline", false, "use only the cache file")
+	maxAge := fs.Duration("max-age", 24*time.Hour, "refresh the cache file when it is older than this")
+	fs.Usage = func() {
+		_, _ = fmt.Fprintln(stderr, "usage: jpxbd [flags] is-business-day|next|prev|add|list|holidays|ics [args]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	if *format != "text" && *format != "json" && *format != "csv" {
+		_, _ = fmt.Fprintf(stderr, "jpxbd: unknown format %q\n", *format)
+		return exitError
+	}
+	if fs.NArg() < 1 {
+		fs.Usage()
+		return exitError
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	bd, err := loadBusinessDay(ctx, *cache, *offline, *maxAge, stderr)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "jpxbd: %v\n", err)
+		return exitError
+	}
+
+	out := &output{w: stdout, format: *format}
+	code, err := command(bd, fs.Arg(0), fs.Args()[1:], out)
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "jpxbd: %v\n", err)
+		return exitError
+	}
+	return code
+}
+
+// command - サブコマンドを実行して終了コードを返す
+func command(bd jbd.BusinessDay, name string, args []string, out *output) (int, error) {
+	switch name {
+	case "is-business-day":
+		d, err := dateArg(bd, args, 0)
+		if err != nil {
+			return exitError, err
+		}
+		ok := bd.IsBusinessDay(d)
+		if err := out.write(true, []string{"date", "businessDay"}, [][]interface{}{{d, ok}}); err != nil {
+			return exitError, err
+		}
+		if !ok {
+			return exitFalse, nil
+		}
+		return exitOK, nil
+	case "next", "prev":
+		d, err := dateArg(bd, args, 0)
+		if err != nil {
+			return exitError, err
+		}
+		n := 1
+		if name == "prev" {
+			n = -1
+		}
+		return exitOK, out.write(true, []string{"date"}, [][]interface{}{{bd.AddBusinessDays(d, n)}})
+	case "add":
+		if len(args) < 1 {
+			return exitError, errors.New("add needs N")
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil {
+			return exitError, fmt.Errorf("N is not a number: %w", err)
+		}
+		d, err := dateArg(bd, args, 1)
+		if err != nil {
+			return exitError, err
+		}
+		return exitOK, out.write(true, []string{"date"}, [][]interface{}{{bd.AddBusinessDays(d, n)}})
+	case "list":
+		fs := flag.NewFlagSet("list", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		from := fs.String("from", "", "first date")
+		to := fs.String("to", "", "last date")
+		if err := fs.Parse(args); err != nil {
+			return exitError, err
+		}
+		f, err := parseDate(*from)
+		if err != nil {
+			return exitError, fmt.Errorf("-from: %w", err)
+		}
+		t, err := parseDate(*to)
+		if err != nil {
+			return exitError, fmt.Errorf("-to: %w", err)
+		}
+		rows := [][]interface{}{}
+		bd.Each(f, t, func(date time.Time) bool {
+			rows = append(rows, []interface{}{date})
+			return true
+		})
+		return exitOK, out.write(false, []string{"date"}, rows)
+	case "holidays":
+		if len(args) < 1 {
+			return exitError, errors.New("holidays needs YEAR")
+		}
+		year, err := strconv.Atoi(args[0])
+		if err != nil {
+			return exitError, fmt.Errorf("YEAR is not a number: %w", err)
+		}
+		rows := [][]interface{}{}
+		for _, h := range bd.Holidays(time.Date(year, 1, 1, 0, 0, 0, 0, time.Local), time.Date(year, 12, 31, 0, 0, 0, 0, time.Local)) {
+			rows = append(rows, []interface{}{h.Date, h.Name, h.Kind.String()})
+		}
+		return exitOK, out.write(false, []string{"date", "name", "kind"}, rows)
+	case "ics":
+		from, to := time.Time{}, bd.LastHoliday()
+		if len(args) > 0 {
+			year, err := strconv.Atoi(args[0])
+			if err != nil {
+				return exitError, fmt.Errorf("YEAR is not a number: %w", err)
+			}
+			from, to = time.Date(year, 1, 1, 0, 0, 0, 0, time.Local), time.Date(year, 12, 31, 0, 0, 0, 0, time.Local)
+		}
+		return exitOK, jbd.WriteICS(out.w, bd.Holidays(from, to), bd.LastUpdateDate())
+	}
+	return exitError, fmt.Errorf("unknown command %q", name)
+}
+
+// dateArg - args[i]の日付
+// 省略されていれば今日を返す
+func dateArg(bd jbd.BusinessDay, args []string, i int) (time.Time, error) {
+	if len(args) <= i {
+		return bd.Today(), nil
+	}
+	return parseDate(args[i])
+}
+
+// parseDate - 2006-01-02か2006/01/02の形式の日付
+func parseDate(s string) (time.Time, error) {
+	return time.ParseInLocation(dateLayout, strings.ReplaceAll(s, "/", "-"), time.Local)
+}
+
+// output - 結果をformatに従って書き出す
+type output struct {
+	w      io.Writer
+	format string
+}
+
+// write - columnsを列名としてrowsを書き出す
+// jsonではsingleなら1つ目の行をオブジェクトで、そうでなければ配列で書き出す
+func (o *output) write(single bool, columns []string, rows [][]interface{}) error {
+	switch o.format {
+	case "json":
+		objects := make([]map[string]interface{}, 0, len(rows))
+		for _, row := range rows {
+			object := map[string]interface{}{}
+			for i, v := range row {
+				if t, ok := v.(time.Time); ok {
+					v = t.Format(dateLayout)
+				}
+				object[columns[i]] = v
+			}
+			objects = append(objects, object)
+		}
+		enc := json.NewEncoder(o.w)
+		if single && len(objects) > 0 {
+			return enc.Encode(objects[0])
+		}
+		return enc.Encode(objects)
+	case "csv":
+		w := csv.NewWriter(o.w)
+		if err := w.Write(columns); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := w.Write(stringValues(row)); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	}
+
+	for _, row := range rows {
+		if _, err := fmt.Fprintln(o.w, strings.Join(stringValues(row), "\t")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stringValues - 行の値を文字列にする
+func stringValues(row []interface{}) []string {
+	values := make([]string, 0, len(row))
+	for _, v := range row {
+		if t, ok := v.(time.Time); ok {
+			values = append(values, t.Format(dateLayout))
+			continue
+		}
+		values = append(values, fmt.Sprint(v))
+	}
+	return values
+}
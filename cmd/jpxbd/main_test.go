@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testCache = `{
+  "lastUpdateDate": "2021-01-07",
+  "holidays": [
+    {"date": "2021-05-03", "name": "憲法記念日", "kind": "祝日", "market": "未指定", "source": ""},
+    {"date": "2021-05-04", "name": "みどりの日", "kind": "祝日", "market": "未指定", "source": ""},
+    {"date": "2021-05-05", "name": "こどもの日", "kind": "祝日", "market": "未指定", "source": ""},
+    {"date": "2021-12-31", "name": "休業日", "kind": "休業日", "market": "未指定", "source": ""}
+  ]
+}`
+
+func Test_run(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "holidays.json")
+	if err := os.WriteFile(path, []byte(testCache), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		wantOut  string
+	}{
+		{name: "営業日なら0", args: []string{"is-business-day", "2021-05-06"}, wantCode: exitOK, wantOut: "2021-05-06\ttrue\n"},
+		{name: "休業日なら1", args: []string{"is-business-day", "2021/05/05"}, wantCode: exitFalse, wantOut: "2021-05-05\tfalse\n"},
+		{name: "jsonで出力できる", args: []string{"-format", "json", "is-business-day", "2021-05-05"}, wantCode: exitFalse, wantOut: `{"businessDay":false,"date":"2021-05-05"}` + "\n"},
+		{name: "翌営業日", args: []string{"next", "2021-04-30"}, wantCode: exitOK, wantOut: "2021-05-06\n"},
+		{name: "前営業日", args: []string{"prev", "2021-05-06"}, wantCode: exitOK, wantOut: "2021-04-30\n"},
+		{name: "N営業日後", args: []string{"add", "3", "2021-04-30"}, wantCode: exitOK, wantOut: "2021-05-10\n"},
+		{name: "N営業日前", args: []string{"add", "-2", "2021-05-06"}, wantCode: exitOK, wantOut: "2021-04-29\n"},
+		{name: "期間内の営業日をcsvで出力できる",
+			args:     []string{"-format", "csv", "list", "-from", "2021-04-30", "-to", "2021-05-07"},
+			wantCode: exitOK,
+			wantOut:  "date\n2021-04-30\n2021-05-06\n2021-05-07\n"},
+		{name: "年の休日",
+			args:     []string{"holidays", "2021"},
+			wantCode: exitOK,
+			wantOut:  "2021-05-03\t憲法記念日\t祝日\n2021-05-04\tみどりの日\t祝日\n2021-05-05\tこどもの日\t祝日\n2021-12-31\t休業日\t休業日\n"},
+		{name: "年の休日をjsonで出力できる",
+			args:     []string{"-format", "json", "holidays", "2022"},
+			wantCode: exitOK,
+			wantOut:  "[]\n"},
+		{name: "年の休日をiCalendarで出力できる",
+			args:     []string{"ics", "2022"},
+			wantCode: exitOK,
+			wantOut:  "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//tsuchinaga//jpx-business-day//JA\r\nCALSCALE:GREGORIAN\r\nMETHOD:PUBLISH\r\nX-WR-CALNAME:JPX休業日\r\nEND:VCALENDAR\r\n"},
+		{name: "不明なコマンドはエラー", args: []string{"unknown"}, wantCode: exitError, wantOut: ""},
+		{name: "不正な日付はエラー", args: []string{"next", "20210430"}, wantCode: exitError, wantOut: ""},
+		{name: "不明な形式はエラー", args: []string{"-format", "xml", "next"}, wantCode: exitError, wantOut: ""},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+			args := append([]string{"-offline", "-cache", path}, test.args...)
+			gotCode := run(args, stdout, stderr)
+			if !reflect.DeepEqual(test.wantCode, gotCode) || !reflect.DeepEqual(test.wantOut, stdout.String()) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v, %s\n", t.Name(), test.wantCode, test.wantOut, gotCode, stdout.String(), stderr.String())
+			}
+		})
+	}
+}
+
+func Test_run_NoCache(t *testing.T) {
+	t.Parallel()
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	got := run([]string{"-offline", "-cache", filepath.Join(t.TempDir(), "none.json"), "next"}, stdout, stderr)
+	if got != exitError {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), exitError, got)
+	}
+}
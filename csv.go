@@ -0,0 +1,86 @@
+package jpx_business_day
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// csvHeader - 休日一覧のCSVの見出し
+var csvHeader = []string{"date", "name", "kind", "market", "source"}
+
+// WriteCSV - 休日の一覧を見出し付きのCSVで書き出す
+// 列は日付、名称、種類、市場、取得元の順で、種類と市場はStringの値を書く
+func WriteCSV(w io.Writer, holidays []Holiday, opt FormatOption) error {
+	buf := &bytes.Buffer{}
+	cw := csv.NewWriter(buf)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, h := range holidays {
+		record := []string{h.Date.Format(opt.dateLayout()), h.Name, h.Kind.String(), h.Market.String(), h.Source}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+	return opt.write(w, buf.Bytes())
+}
+
+// ReadCSV - WriteCSVの形式のCSVから休日の一覧を読み込む
+// 1行目が見出しなら読み飛ばし、日付と名称より後ろの列は省略できる
+func ReadCSV(r io.Reader, opt FormatOption) ([]Holiday, error) {
+	cr := csv.NewReader(opt.reader(r))
+	cr.FieldsPerRecord = -1
+
+	holidays := make([]Holiday, 0)
+	for line := 1; ; line++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && record[0] == csvHeader[0] {
+			continue
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: too few fields, %w", line, InvalidArgumentError)
+		}
+
+		h, err := parseCSVRecord(record, opt)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		holidays = append(holidays, h)
+	}
+	return holidays, nil
+}
+
+// parseCSVRecord - CSVの1行を休日にする
+func parseCSVRecord(record []string, opt FormatOption) (Holiday, error) {
+	d, err := opt.parseDate(record[0])
+	if err != nil {
+		return Holiday{}, err
+	}
+	h := Holiday{Date: d, Name: record[1]}
+	if len(record) > 2 {
+		if h.Kind, err = parseHolidayKind(record[2]); err != nil {
+			return Holiday{}, err
+		}
+	}
+	if len(record) > 3 {
+		if h.Market, err = parseMarket(record[3]); err != nil {
+			return Holiday{}, err
+		}
+	}
+	if len(record) > 4 {
+		h.Source = record[4]
+	}
+	return h, nil
+}
@@ -0,0 +1,114 @@
+package jpx_business_day
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_WriteCSV(t *testing.T) {
+	t.Parallel()
+	holidays := []Holiday{
+		{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational, Source: "https://www.jpx.co.jp/corporate/about-jpx/calendar/"},
+		{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日", Kind: HolidayKindExchange, Market: MarketTSECash},
+	}
+	tests := []struct {
+		name string
+		opt  FormatOption
+		want string
+	}{
+		{name: "既定の日付の形式",
+			opt: FormatOption{},
+			want: "date,name,kind,market,source\n" +
+				"2021/01/01,元日,祝日,未指定,https://www.jpx.co.jp/corporate/about-jpx/calendar/\n" +
+				"2021/12/31,休業日,休業日,東京証券取引所,\n"},
+		{name: "日付の形式を指定できる",
+			opt: FormatOption{DateLayout: "2006-01-02"},
+			want: "date,name,kind,market,source\n" +
+				"2021-01-01,元日,祝日,未指定,https://www.jpx.co.jp/corporate/about-jpx/calendar/\n" +
+				"2021-12-31,休業日,休業日,東京証券取引所,\n"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			buf := &bytes.Buffer{}
+			err := WriteCSV(buf, holidays, test.opt)
+			if !reflect.DeepEqual(test.want, buf.String()) || err != nil {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), test.want, buf.String(), err)
+			}
+		})
+	}
+}
+
+func Test_ReadCSV(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		arg     string
+		opt     FormatOption
+		want    []Holiday
+		wantErr error
+	}{
+		{name: "空なら空を返す", arg: "", want: []Holiday{}, wantErr: nil},
+		{name: "見出しを読み飛ばす",
+			arg: "date,name,kind,market,source\n2021/01/01,元日,祝日,東京証券取引所,jpx\n",
+			want: []Holiday{
+				{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational, Market: MarketTSECash, Source: "jpx"},
+			},
+			wantErr: nil},
+		{name: "見出しがなくても読める",
+			arg: "2021/01/01,元日,祝日\n2021/12/31,休業日\n",
+			want: []Holiday{
+				{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational},
+				{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日"},
+			},
+			wantErr: nil},
+		{name: "日付の形式を指定できる",
+			arg: "20210101,元日\n",
+			opt: FormatOption{DateLayout: "20060102"},
+			want: []Holiday{
+				{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日"},
+			},
+			wantErr: nil},
+		{name: "日付の形式が違えばエラー", arg: "2021-01-01,元日\n", want: nil, wantErr: TimeParseError},
+		{name: "名称がなければエラー", arg: "2021/01/01\n", want: nil, wantErr: InvalidArgumentError},
+		{name: "知らない種類はエラー", arg: "2021/01/01,元日,national\n", want: nil, wantErr: InvalidArgumentError},
+		{name: "知らない市場はエラー", arg: "2021/01/01,元日,祝日,TSE\n", want: nil, wantErr: InvalidArgumentError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ReadCSV(strings.NewReader(test.arg), test.opt)
+			if !reflect.DeepEqual(test.want, got) || !errors.Is(err, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantErr, got, err)
+			}
+		})
+	}
+}
+
+func Test_ReadCSV_WriteCSV_ShiftJIS(t *testing.T) {
+	t.Parallel()
+	holidays := []Holiday{
+		{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational, Market: MarketOSEDerivatives, Source: "jpx"},
+		{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日, 年末", Kind: HolidayKindExchange},
+	}
+	opt := FormatOption{Encoding: EncodingShiftJIS}
+	buf := &bytes.Buffer{}
+	if err := WriteCSV(buf, holidays, opt); err != nil {
+		t.Fatalf("%s error: %+v\n", t.Name(), err)
+	}
+	if strings.Contains(buf.String(), "元日") {
+		t.Errorf("%s error: not encoded to Shift_JIS\n", t.Name())
+	}
+	got, err := ReadCSV(buf, opt)
+	if !reflect.DeepEqual(holidays, got) || err != nil {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), holidays, got, err)
+	}
+}
@@ -0,0 +1,38 @@
+package jpx_business_day
+
+import "time"
+
+// DayCount - 2つの日付の間を年単位の割合にする日数計算の方法
+type DayCount interface {
+	YearFraction(from, to time.Time) float64
+}
+
+// NewBus252 - bdの営業日を使うBUS/252
+// fromからtoの前日までの営業日数を252で割る
+func NewBus252(bd BusinessDay) DayCount {
+	return &bus252{businessDay: bd}
+}
+
+type bus252 struct {
+	businessDay BusinessDay
+}
+
+// YearFraction - fromからtoまでの年単位の割合
+// toがfromより前なら負になる
+func (d *bus252) YearFraction(from, to time.Time) float64 {
+	return float64(d.businessDay.BusinessDaysBetween(from, to)) / 252
+}
+
+// NewAct365Fixed - ACT/365F
+// fromからtoまでの実日数を365で割る
+func NewAct365Fixed() DayCount {
+	return &act365Fixed{}
+}
+
+type act365Fixed struct{}
+
+// YearFraction - fromからtoまでの年単位の割合
+// toがfromより前なら負になる
+func (d *act365Fixed) YearFraction(from, to time.Time) float64 {
+	return float64(dayNumber(to)-dayNumber(from)) / 365
+}
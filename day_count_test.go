@@ -0,0 +1,86 @@
+package jpx_business_day
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_bus252_YearFraction(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local): {{Name: "憲法記念日"}},
+			time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local): {{Name: "みどりの日"}},
+			time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local): {{Name: "こどもの日"}},
+		},
+		lastHoliday: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want float64
+	}{
+		{name: "同じ日なら0",
+			from: time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			want: 0},
+		{name: "休日を除いた営業日数を252で割る",
+			from: time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 5, 10, 0, 0, 0, 0, time.Local),
+			want: 3.0 / 252},
+		{name: "逆順なら負",
+			from: time.Date(2021, 5, 10, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			want: -3.0 / 252},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewBus252(bd).YearFraction(test.from, test.to)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_act365Fixed_YearFraction(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want float64
+	}{
+		{name: "同じ日なら0",
+			from: time.Date(2021, 4, 30, 10, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 4, 30, 15, 0, 0, 0, time.Local),
+			want: 0},
+		{name: "休日も含めた実日数を365で割る",
+			from: time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 5, 10, 0, 0, 0, 0, time.Local),
+			want: 10.0 / 365},
+		{name: "うるう年でも365で割る",
+			from: time.Date(2020, 1, 1, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local),
+			want: 366.0 / 365},
+		{name: "逆順なら負",
+			from: time.Date(2021, 5, 10, 0, 0, 0, 0, time.Local),
+			to:   time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			want: -10.0 / 365},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewAct365Fixed().YearFraction(test.from, test.to)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package jpx_business_day
+
+import "time"
+
+// oseDerivativesSchedules - 大阪取引所の先物・オプション市場の立会時間の変遷
+// 日中立会、夜間立会ともにクロージング・オークションを含む
+// 2011/07/19より前の立会時間は持っていない
+var oseDerivativesSchedules = []sessionSchedule{
+	{effectiveFrom: time.Date(2011, 7, 19, 0, 0, 0, 0, exchangeLocation), sessions: []Session{
+		{Type: SessionTypeDay, Start: 9 * time.Hour, End: 15*time.Hour + 15*time.Minute},
+		{Type: SessionTypeNight, Start: 16*time.Hour + 30*time.Minute, End: 27 * time.Hour},
+	}},
+	// 日中立会の開始を8:45に、夜間立会の終了を翌5:30に変更
+	{effectiveFrom: time.Date(2016, 7, 19, 0, 0, 0, 0, exchangeLocation), sessions: []Session{
+		{Type: SessionTypeDay, Start: 8*time.Hour + 45*time.Minute, End: 15*time.Hour + 15*time.Minute},
+		{Type: SessionTypeNight, Start: 16*time.Hour + 30*time.Minute, End: 29*time.Hour + 30*time.Minute},
+	}},
+	// 夜間立会の終了を翌6:00に延長
+	{effectiveFrom: time.Date(2021, 9, 21, 0, 0, 0, 0, exchangeLocation), sessions: []Session{
+		{Type: SessionTypeDay, Start: 8*time.Hour + 45*time.Minute, End: 15*time.Hour + 15*time.Minute},
+		{Type: SessionTypeNight, Start: 16*time.Hour + 30*time.Minute, End: 30 * time.Hour},
+	}},
+	// 日中立会の終了を15:45に延長し、夜間立会の開始を17:00に変更
+	{effectiveFrom: time.Date(2024, 11, 5, 0, 0, 0, 0, exchangeLocation), sessions: []Session{
+		{Type: SessionTypeDay, Start: 8*time.Hour + 45*time.Minute, End: 15*time.Hour + 45*time.Minute},
+		{Type: SessionTypeNight, Start: 17 * time.Hour, End: 30 * time.Hour},
+	}},
+}
+
+// DerivativesSessions - dateに始まる大阪取引所の先物・オプション市場の立会時間
+// 休業日かどうかは考慮しない
+func DerivativesSessions(date time.Time) []Session {
+	return sessionsAt(oseDerivativesSchedules, date)
+}
+
+// IsDerivativesOpen - 大阪取引所の先物・オプション市場の立会時間中かどうか
+// 夜間立会は翌日が休日でも翌朝まで行われる
+// 祝日取引の実施日は休日でも立会がある
+func (b *businessDay) IsDerivativesOpen(target time.Time) bool {
+	return NewMarketCalendar(MarketOSEDerivatives, b).IsOpen(target)
+}
+
+// DerivativesSession - targetで立会中、もしくは次に始まる先物・オプション市場の立会と、その立会の取引日
+// 見つからなければゼロ値を返す
+func (b *businessDay) DerivativesSession(target time.Time) (Session, time.Time) {
+	return NewMarketCalendar(MarketOSEDerivatives, b).Session(target)
+}
+
+// TradeDate - targetが属する先物・オプション市場の取引日
+// 立会時間外であれば次に始まる立会の取引日を返す
+func (b *businessDay) TradeDate(target time.Time) time.Time {
+	return NewMarketCalendar(MarketOSEDerivatives, b).TradeDate(target)
+}
@@ -0,0 +1,120 @@
+package jpx_business_day
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_DerivativesSessions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		arg  time.Time
+		want []Session
+	}{
+		{name: "2011/07/19より前は立会時間を持っていない",
+			arg:  time.Date(2011, 7, 15, 0, 0, 0, 0, time.Local),
+			want: []Session{}},
+		{name: "2016/07/19からは夜間立会が翌5:30まで",
+			arg: time.Date(2016, 7, 19, 0, 0, 0, 0, time.Local),
+			want: []Session{
+				{Type: SessionTypeDay, Start: 8*time.Hour + 45*time.Minute, End: 15*time.Hour + 15*time.Minute},
+				{Type: SessionTypeNight, Start: 16*time.Hour + 30*time.Minute, End: 29*time.Hour + 30*time.Minute},
+			}},
+		{name: "2024/11/05からは日中立会が15:45まで、夜間立会が17:00から",
+			arg: time.Date(2024, 11, 5, 0, 0, 0, 0, time.Local),
+			want: []Session{
+				{Type: SessionTypeDay, Start: 8*time.Hour + 45*time.Minute, End: 15*time.Hour + 45*time.Minute},
+				{Type: SessionTypeNight, Start: 17 * time.Hour, End: 30 * time.Hour},
+			}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := DerivativesSessions(test.arg)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_marketCalendar_Session_Derivatives(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local):  {{Name: "憲法記念日"}},
+			time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local):  {{Name: "みどりの日"}},
+			time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local):  {{Name: "こどもの日"}},
+			time.Date(2021, 9, 23, 0, 0, 0, 0, time.Local): {{Name: "秋分の日"}},
+		},
+		lastHoliday: time.Date(2021, 9, 23, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name          string
+		arg           time.Time
+		wantOpen      bool
+		wantType      SessionType
+		wantTradeDate time.Time
+	}{
+		{name: "金曜日の夜間立会は連休明けの取引日",
+			arg:           time.Date(2021, 4, 30, 20, 0, 0, 0, exchangeLocation),
+			wantOpen:      true,
+			wantType:      SessionTypeNight,
+			wantTradeDate: time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)},
+		{name: "翌日が休日でも5:30までは夜間立会",
+			arg:           time.Date(2021, 5, 1, 5, 29, 0, 0, exchangeLocation),
+			wantOpen:      true,
+			wantType:      SessionTypeNight,
+			wantTradeDate: time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)},
+		{name: "夜間立会が終われば次の日中立会の取引日",
+			arg:           time.Date(2021, 5, 1, 5, 30, 0, 0, exchangeLocation),
+			wantOpen:      false,
+			wantType:      SessionTypeDay,
+			wantTradeDate: time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)},
+		{name: "祝日は立会がない",
+			arg:           time.Date(2021, 5, 4, 10, 0, 0, 0, exchangeLocation),
+			wantOpen:      false,
+			wantType:      SessionTypeDay,
+			wantTradeDate: time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)},
+		{name: "日中立会の取引日は当日",
+			arg:           time.Date(2021, 5, 6, 10, 0, 0, 0, exchangeLocation),
+			wantOpen:      true,
+			wantType:      SessionTypeDay,
+			wantTradeDate: time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)},
+		{name: "日中立会と夜間立会の間は次の夜間立会の取引日",
+			arg:           time.Date(2021, 5, 6, 16, 0, 0, 0, exchangeLocation),
+			wantOpen:      false,
+			wantType:      SessionTypeNight,
+			wantTradeDate: time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local)},
+		{name: "2021/09/21以降は翌6:00まで夜間立会",
+			arg:           time.Date(2021, 9, 23, 5, 45, 0, 0, exchangeLocation),
+			wantOpen:      true,
+			wantType:      SessionTypeNight,
+			wantTradeDate: time.Date(2021, 9, 24, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			mc := NewMarketCalendar(MarketOSEDerivatives, bd)
+			gotOpen := mc.IsOpen(test.arg)
+			gotSession, gotTradeDate := mc.Session(test.arg)
+			wrappedSession, wrappedTradeDate := bd.DerivativesSession(test.arg)
+			if !reflect.DeepEqual(test.wantOpen, gotOpen) ||
+				!reflect.DeepEqual(test.wantType, gotSession.Type) ||
+				!reflect.DeepEqual(test.wantTradeDate, gotTradeDate) ||
+				!reflect.DeepEqual(test.wantTradeDate, mc.TradeDate(test.arg)) ||
+				!reflect.DeepEqual(test.wantOpen, bd.IsDerivativesOpen(test.arg)) ||
+				!reflect.DeepEqual(gotSession, wrappedSession) ||
+				!reflect.DeepEqual(test.wantTradeDate, wrappedTradeDate) ||
+				!reflect.DeepEqual(test.wantTradeDate, bd.TradeDate(test.arg)) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(),
+					test.wantOpen, test.wantType, test.wantTradeDate, gotOpen, gotSession.Type, gotTradeDate)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package jpx_business_day
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// Encoding - 休日一覧を読み書きするときの文字コード
+type Encoding int
+
+const (
+	EncodingUTF8     Encoding = iota // UTF-8
+	EncodingShiftJIS                 // Shift_JIS
+)
+
+func (e Encoding) String() string {
+	switch e {
+	case EncodingUTF8:
+		return "UTF-8"
+	case EncodingShiftJIS:
+		return "Shift_JIS"
+	}
+	return "未指定"
+}
+
+// FormatOption - 休日一覧を読み書きするときの設定
+type FormatOption struct {
+	DateLayout string   // 日付の形式 空なら2006/01/02
+	Encoding   Encoding // 文字コード
+}
+
+// dateLayout - 日付の形式
+func (o FormatOption) dateLayout() string {
+	if o.DateLayout == "" {
+		return "2006/01/02"
+	}
+	return o.DateLayout
+}
+
+// parseDate - 日付の形式に合わせて日付を読む
+func (o FormatOption) parseDate(s string) (time.Time, error) {
+	d, err := time.ParseInLocation(o.dateLayout(), s, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%v, %w", err, TimeParseError)
+	}
+	return time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.Local), nil
+}
+
+// write - UTF-8で組み立てたbを文字コードに合わせてwに書き出す
+// Shift_JISで表せない文字があればエラーを返す
+func (o FormatOption) write(w io.Writer, b []byte) error {
+	if o.Encoding == EncodingShiftJIS {
+		var err error
+		if b, err = japanese.ShiftJIS.NewEncoder().Bytes(b); err != nil {
+			return err
+		}
+	}
+	_, err := io.Copy(w, bytes.NewReader(b))
+	return err
+}
+
+// reader - 文字コードに合わせてUTF-8に変換しながら読むReader
+func (o FormatOption) reader(r io.Reader) io.Reader {
+	if o.Encoding == EncodingShiftJIS {
+		return transform.NewReader(r, japanese.ShiftJIS.NewDecoder())
+	}
+	return r
+}
+
+// parseHolidayKind - HolidayKind.Stringの値から休日の種類を戻す
+// 空は未指定として扱う
+func parseHolidayKind(s string) (HolidayKind, error) {
+	for _, k := range []HolidayKind{HolidayKindUnspecified, HolidayKindNational, HolidayKindExchange} {
+		if s == k.String() {
+			return k, nil
+		}
+	}
+	if s == "" {
+		return HolidayKindUnspecified, nil
+	}
+	return HolidayKindUnspecified, fmt.Errorf("unknown holiday kind %q, %w", s, InvalidArgumentError)
+}
+
+// parseMarket - Market.Stringの値から市場を戻す
+// 空は未指定として扱う
+func parseMarket(s string) (Market, error) {
+	for _, m := range []Market{MarketUnspecified, MarketTSECash, MarketOSEDerivatives, MarketTOCOM} {
+		if s == m.String() {
+			return m, nil
+		}
+	}
+	if s == "" {
+		return MarketUnspecified, nil
+	}
+	return MarketUnspecified, fmt.Errorf("unknown market %q, %w", s, InvalidArgumentError)
+}
@@ -0,0 +1,100 @@
+package jpx_business_day
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func Test_FormatOption_write_reader(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		opt     FormatOption
+		arg     string
+		want    []byte
+		wantErr bool
+	}{
+		{name: "UTF-8はそのまま書く", opt: FormatOption{}, arg: "元日", want: []byte("元日"), wantErr: false},
+		{name: "Shift_JISに変換して書く", opt: FormatOption{Encoding: EncodingShiftJIS}, arg: "元日", want: []byte{0x8c, 0xb3, 0x93, 0xfa}, wantErr: false},
+		{name: "Shift_JISで表せない文字はエラー", opt: FormatOption{Encoding: EncodingShiftJIS}, arg: "🎍", want: nil, wantErr: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			buf := &bytes.Buffer{}
+			err := test.opt.write(buf, []byte(test.arg))
+			if (err != nil) != test.wantErr {
+				t.Fatalf("%s error\nwantErr: %+v\ngot: %+v\n", t.Name(), test.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(test.want, buf.Bytes()) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, buf.Bytes())
+			}
+			got, err := io.ReadAll(test.opt.reader(buf))
+			if string(got) != test.arg || err != nil {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), test.arg, string(got), err)
+			}
+		})
+	}
+}
+
+func Test_parseHolidayKind(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		arg     string
+		want    HolidayKind
+		wantErr error
+	}{
+		{name: "空は未指定", arg: "", want: HolidayKindUnspecified, wantErr: nil},
+		{name: "未指定", arg: "未指定", want: HolidayKindUnspecified, wantErr: nil},
+		{name: "祝日", arg: "祝日", want: HolidayKindNational, wantErr: nil},
+		{name: "休業日", arg: "休業日", want: HolidayKindExchange, wantErr: nil},
+		{name: "知らない種類はエラー", arg: "national", want: HolidayKindUnspecified, wantErr: InvalidArgumentError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseHolidayKind(test.arg)
+			if !reflect.DeepEqual(test.want, got) || !errors.Is(err, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantErr, got, err)
+			}
+		})
+	}
+}
+
+func Test_parseMarket(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		arg     string
+		want    Market
+		wantErr error
+	}{
+		{name: "空は未指定", arg: "", want: MarketUnspecified, wantErr: nil},
+		{name: "東京証券取引所", arg: "東京証券取引所", want: MarketTSECash, wantErr: nil},
+		{name: "大阪取引所", arg: "大阪取引所", want: MarketOSEDerivatives, wantErr: nil},
+		{name: "東京商品取引所", arg: "東京商品取引所", want: MarketTOCOM, wantErr: nil},
+		{name: "知らない市場はエラー", arg: "TSE", want: MarketUnspecified, wantErr: InvalidArgumentError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseMarket(test.arg)
+			if !reflect.DeepEqual(test.want, got) || !errors.Is(err, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantErr, got, err)
+			}
+		})
+	}
+}
@@ -1,3 +1,5 @@
 module gitlab.com/tsuchinaga/jpx-business-day
 
 go 1.16
+
+require golang.org/x/text v0.3.6
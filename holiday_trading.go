@@ -0,0 +1,80 @@
+package jpx_business_day
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// IsDerivativesTradingDay - 先物・オプション市場で立会がある日かどうか
+// 営業日に加えて、祝日取引の実施日も立会がある日になる
+func (b *businessDay) IsDerivativesTradingDay(target time.Time) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.isTradingDayIn(MarketOSEDerivatives, target)
+}
+
+// isTradingDayIn - marketで立会がある日かどうか
+// 市場を指定した休日はその市場だけを休みにし、先物・オプション市場と商品先物市場は祝日取引の実施日も立会がある
+// 呼び出し側でロックを取っておくこと
+func (b *businessDay) isTradingDayIn(market Market, target time.Time) bool {
+	if !b.isHolidayIn(market, target) {
+		return true
+	}
+
+	// 現物市場と、土曜日、日曜日に祝日取引はない
+	if market == MarketTSECash || market == MarketUnspecified || target.Weekday() == time.Saturday || target.Weekday() == time.Sunday {
+		return false
+	}
+
+	targetDate := time.Date(target.Year(), target.Month(), target.Day(), 0, 0, 0, 0, time.Local)
+	_, ok := b.holidayTradingDays[targetDate]
+	return ok
+}
+
+// SetHolidayTradingDays - 祝日取引の実施日を設定する
+// 設定済みの実施日は破棄され、Refreshでは変更されない
+// 作り直さずに実施日を差し替えられるので、取得済みの休日情報はそのまま使える
+func (b *businessDay) SetHolidayTradingDays(dates []time.Time) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.setHolidayTradingDays(dates)
+}
+
+// setHolidayTradingDays - 祝日取引の実施日を設定する
+// 呼び出し側でロックを取っておくこと
+func (b *businessDay) setHolidayTradingDays(dates []time.Time) {
+	b.holidayTradingDays = map[time.Time]struct{}{}
+	for _, d := range dates {
+		b.holidayTradingDays[time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.Local)] = struct{}{}
+	}
+}
+
+// ReadHolidayTradingDays - 祝日取引の実施日の一覧を読み込む
+// 1行に1日を2006/01/02の形式で書き、空行と#から始まる行は無視する
+// 日付の後ろに空白を挟んで書いた内容も無視する
+func ReadHolidayTradingDays(r io.Reader) ([]time.Time, error) {
+	var dates []time.Time
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Fields(text)
+		d, err := time.ParseInLocation("2006/01/02", fields[0], time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v, %w", line, err, TimeParseError)
+		}
+		dates = append(dates, d)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return dates, nil
+}
@@ -0,0 +1,145 @@
+package jpx_business_day
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_businessDay_IsDerivativesTradingDay(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2022, 9, 19, 0, 0, 0, 0, time.Local): {{Name: "敬老の日"}},
+			time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local): {{Name: "秋分の日"}},
+		},
+		lastHoliday: time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local)}
+	WithHolidayTradingDays([]time.Time{
+		time.Date(2022, 9, 23, 10, 0, 0, 0, time.Local),
+		time.Date(2022, 9, 24, 0, 0, 0, 0, time.Local),
+	})(bd)
+	tests := []struct {
+		name string
+		arg  time.Time
+		want bool
+	}{
+		{name: "営業日はtrue", arg: time.Date(2022, 9, 22, 0, 0, 0, 0, time.Local), want: true},
+		{name: "祝日取引の実施日はtrue", arg: time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local), want: true},
+		{name: "祝日取引の実施日でない祝日はfalse", arg: time.Date(2022, 9, 19, 0, 0, 0, 0, time.Local), want: false},
+		{name: "土曜日は実施日に含まれていてもfalse", arg: time.Date(2022, 9, 24, 0, 0, 0, 0, time.Local), want: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := bd.IsDerivativesTradingDay(test.arg)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_businessDay_SetHolidayTradingDays(t *testing.T) {
+	t.Parallel()
+	bd := NewBusinessDayFromHolidays([]Holiday{
+		{Date: time.Date(2022, 9, 19, 0, 0, 0, 0, time.Local), Name: "敬老の日"},
+		{Date: time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local), Name: "秋分の日"},
+	}, time.Date(2022, 1, 7, 0, 0, 0, 0, time.Local), WithHolidayTradingDays([]time.Time{time.Date(2022, 9, 19, 0, 0, 0, 0, time.Local)}))
+	mc := NewMarketCalendar(MarketOSEDerivatives, bd)
+	if !mc.IsTradingDay(time.Date(2022, 9, 19, 0, 0, 0, 0, time.Local)) || mc.IsTradingDay(time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local)) {
+		t.Fatalf("%s error: holiday trading days are not set by the option\n", t.Name())
+	}
+
+	bd.SetHolidayTradingDays([]time.Time{time.Date(2022, 9, 23, 10, 0, 0, 0, time.Local)})
+	want := []bool{false, true, false}
+	got := []bool{
+		mc.IsTradingDay(time.Date(2022, 9, 19, 0, 0, 0, 0, time.Local)),
+		mc.IsTradingDay(time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local)),
+		NewMarketCalendar(MarketTSECash, bd).IsTradingDay(time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local)),
+	}
+	if !reflect.DeepEqual(want, got) || bd.LastHoliday().IsZero() {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), want, got, bd.LastHoliday())
+	}
+}
+
+func Test_marketCalendar_HolidayTrading(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local): {{Name: "秋分の日"}},
+		},
+		lastHoliday: time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local)}
+	WithHolidayTradingDays([]time.Time{time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local)})(bd)
+	tests := []struct {
+		name          string
+		arg           time.Time
+		wantOpen      bool
+		wantCashOpen  bool
+		wantTradeDate time.Time
+	}{
+		{name: "祝日取引の前日の夜間立会は祝日明けの取引日",
+			arg:           time.Date(2022, 9, 22, 20, 0, 0, 0, exchangeLocation),
+			wantOpen:      true,
+			wantCashOpen:  false,
+			wantTradeDate: time.Date(2022, 9, 26, 0, 0, 0, 0, time.Local)},
+		{name: "祝日取引の日中立会は開いていて祝日明けの取引日",
+			arg:           time.Date(2022, 9, 23, 10, 0, 0, 0, exchangeLocation),
+			wantOpen:      true,
+			wantCashOpen:  false,
+			wantTradeDate: time.Date(2022, 9, 26, 0, 0, 0, 0, time.Local)},
+		{name: "祝日取引の夜間立会も祝日明けの取引日",
+			arg:           time.Date(2022, 9, 23, 20, 0, 0, 0, exchangeLocation),
+			wantOpen:      true,
+			wantCashOpen:  false,
+			wantTradeDate: time.Date(2022, 9, 26, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			gotOpen := NewMarketCalendar(MarketOSEDerivatives, bd).IsOpen(test.arg)
+			gotCashOpen := NewMarketCalendar(MarketTSECash, bd).IsOpen(test.arg)
+			gotTradeDate := NewMarketCalendar(MarketOSEDerivatives, bd).TradeDate(test.arg)
+			if !reflect.DeepEqual(test.wantOpen, gotOpen) || !reflect.DeepEqual(test.wantCashOpen, gotCashOpen) || !reflect.DeepEqual(test.wantTradeDate, gotTradeDate) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(),
+					test.wantOpen, test.wantCashOpen, test.wantTradeDate, gotOpen, gotCashOpen, gotTradeDate)
+			}
+		})
+	}
+}
+
+func Test_ReadHolidayTradingDays(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		arg     string
+		want    []time.Time
+		wantErr error
+	}{
+		{name: "空なら空を返す", arg: "", want: nil, wantErr: nil},
+		{name: "空行とコメントと日付の後ろの内容は無視する",
+			arg: "# 祝日取引\n2022/09/23 秋分の日\n\n2022/10/10\n",
+			want: []time.Time{
+				time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local),
+				time.Date(2022, 10, 10, 0, 0, 0, 0, time.Local),
+			},
+			wantErr: nil},
+		{name: "日付でなければエラー", arg: "2022/09/23\n2022-10-10\n", want: nil, wantErr: TimeParseError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ReadHolidayTradingDays(strings.NewReader(test.arg))
+			if !reflect.DeepEqual(test.want, got) || !errors.Is(err, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantErr, got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package jpx_business_day
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// WriteICS - 休日をiCalendar(RFC 5545)の終日の予定として書き出す
+// UIDは日付から作るので、同じ休日は何度書き出しても同じ予定になる
+// stampはDTSTAMPに使い、LastUpdateDateを渡せば休日情報が更新されたときだけ変わる
+func WriteICS(w io.Writer, holidays []Holiday, stamp time.Time) error {
+	bw := bufio.NewWriter(w)
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//tsuchinaga//jpx-business-day//JA",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:JPX休業日",
+	}
+	for _, h := range holidays {
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+h.Date.Format("20060102")+"@jpx-business-day",
+			"DTSTAMP:"+stamp.UTC().Format("20060102T150405Z"),
+			"DTSTART;VALUE=DATE:"+h.Date.Format("20060102"),
+			"DTEND;VALUE=DATE:"+h.Date.AddDate(0, 0, 1).Format("20060102"),
+			"SUMMARY:"+escapeICSText(h.Name),
+			"CATEGORIES:"+escapeICSText(h.Kind.String()),
+			"TRANSP:TRANSPARENT",
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	for _, line := range lines {
+		if _, err := bw.WriteString(foldICSLine(line)); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// escapeICSText - TEXTの値に使えない文字をエスケープする
+func escapeICSText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// foldICSLine - 75オクテットを超える行を折り返し、CRLFをつける
+// マルチバイト文字の途中では折り返さない
+func foldICSLine(line string) string {
+	var sb strings.Builder
+	limit := 75
+	for len(line) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+		sb.WriteString(line[:i])
+		sb.WriteString("\r\n ")
+		line = line[i:]
+		// 折り返した行は先頭の空白も含めて75オクテットにする
+		limit = 74
+	}
+	sb.WriteString(line)
+	sb.WriteString("\r\n")
+	return sb.String()
+}
+
+// ReadICS - iCalendar(RFC 5545)の終日の予定を休日として読み込む
+// 時刻のある予定と取り消された予定は読み飛ばし、複数日の予定は1日ずつの休日にする
+// 繰り返しの予定(RRULE、RDATE、EXDATE)は展開しないのでエラーを返す
+// NewStaticCalendarに渡せば、JPXのカレンダーと組み合わせられるカレンダーになる
+func ReadICS(r io.Reader) ([]Holiday, error) {
+	lines, err := unfoldICSLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays := make([]Holiday, 0)
+	var event map[string]string
+	var params map[string]string
+	depth := 0 // 予定の中のVALARMなどの入れ子の深さ
+	for _, line := range lines {
+		name, param, value := parseICSLine(line)
+		switch {
+		case event == nil:
+			if name == "BEGIN" && value == "VEVENT" {
+				event, params = map[string]string{}, map[string]string{}
+			}
+		case name == "BEGIN":
+			depth++
+		case name == "END" && depth > 0:
+			depth--
+		case name == "END" && value == "VEVENT":
+			hs, err := icsEventHolidays(event, params)
+			if err != nil {
+				return nil, err
+			}
+			holidays = append(holidays, hs...)
+			event, params = nil, nil
+		case depth == 0:
+			event[name], params[name] = value, param
+		}
+	}
+	return holidays, nil
+}
+
+// unfoldICSLines - 折り返された行を元の行に戻す
+func unfoldICSLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, scanner.Err()
+}
+
+// parseICSLine - 行をプロパティ名、パラメータ、値に分ける
+// ダブルクォートで囲まれたパラメータの中の:は区切りとして扱わない
+func parseICSLine(line string) (name, param, value string) {
+	quoted := false
+	for i, c := range line {
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case c == ':' && !quoted:
+			name, value = line[:i], line[i+1:]
+			if j := strings.Index(name, ";"); j >= 0 {
+				name, param = name[:j], name[j+1:]
+			}
+			return strings.ToUpper(name), strings.ToUpper(param), value
+		}
+	}
+	return strings.ToUpper(line), "", ""
+}
+
+// icsEventHolidays - 終日の予定を1日ずつの休日にする
+// DTSTARTが時刻を含むか、VALUEがDATE以外なら終日の予定ではないので読み飛ばす
+// STATUSがCANCELLEDなら読み飛ばし、繰り返しの予定ならエラーを返す
+func icsEventHolidays(event, params map[string]string) ([]Holiday, error) {
+	if strings.EqualFold(event["STATUS"], "CANCELLED") {
+		return nil, nil
+	}
+	for _, name := range []string{"RRULE", "RDATE", "EXDATE"} {
+		if _, ok := event[name]; ok {
+			return nil, fmt.Errorf("%s is not supported: %w", name, InvalidArgumentError)
+		}
+	}
+	start, ok := event["DTSTART"]
+	if !ok || strings.Contains(start, "T") {
+		return nil, nil
+	}
+	switch icsParam(params["DTSTART"], "VALUE") {
+	case "DATE":
+	case "":
+		if len(start) != 8 {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+	from, err := time.ParseInLocation("20060102", start, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("DTSTART %s: %v, %w", start, err, TimeParseError)
+	}
+	to := from.AddDate(0, 0, 1)
+	if end, ok := event["DTEND"]; ok {
+		if to, err = time.ParseInLocation("20060102", end, time.Local); err != nil {
+			return nil, fmt.Errorf("DTEND %s: %v, %w", end, err, TimeParseError)
+		}
+	}
+
+	name := unescapeICSText(event["SUMMARY"])
+	kind := HolidayKindUnspecified
+	switch unescapeICSText(event["CATEGORIES"]) {
+	case HolidayKindNational.String():
+		kind = HolidayKindNational
+	case HolidayKindExchange.String():
+		kind = HolidayKindExchange
+	}
+
+	var holidays []Holiday
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		holidays = append(holidays, Holiday{Date: d, Name: name, Kind: kind})
+	}
+	if len(holidays) == 0 {
+		holidays = append(holidays, Holiday{Date: from, Name: name, Kind: kind})
+	}
+	return holidays, nil
+}
+
+// icsParam - パラメータの並びからnameの値を返す
+// nameがなければ空を返す
+func icsParam(params, name string) string {
+	for _, p := range strings.Split(params, ";") {
+		if i := strings.Index(p, "="); i >= 0 && p[:i] == name {
+			return strings.Trim(p[i+1:], `"`)
+		}
+	}
+	return ""
+}
+
+// unescapeICSText - エスケープされたTEXTの値を戻す
+func unescapeICSText(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n").Replace(s)
+}
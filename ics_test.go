@@ -0,0 +1,186 @@
+package jpx_business_day
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_WriteICS(t *testing.T) {
+	t.Parallel()
+	holidays := []Holiday{
+		{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational},
+		{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日", Kind: HolidayKindExchange},
+	}
+	buf := &bytes.Buffer{}
+	if err := WriteICS(buf, holidays, time.Date(2021, 1, 7, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("%s error: %+v\n", t.Name(), err)
+	}
+
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//tsuchinaga//jpx-business-day//JA",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:JPX休業日",
+		"BEGIN:VEVENT",
+		"UID:20210101@jpx-business-day",
+		"DTSTAMP:20210107T000000Z",
+		"DTSTART;VALUE=DATE:20210101",
+		"DTEND;VALUE=DATE:20210102",
+		"SUMMARY:元日",
+		"CATEGORIES:祝日",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:20211231@jpx-business-day",
+		"DTSTAMP:20210107T000000Z",
+		"DTSTART;VALUE=DATE:20211231",
+		"DTEND;VALUE=DATE:20220101",
+		"SUMMARY:休業日",
+		"CATEGORIES:休業日",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	if !reflect.DeepEqual(want, buf.String()) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, buf.String())
+	}
+}
+
+func Test_escapeICSText(t *testing.T) {
+	t.Parallel()
+	got := escapeICSText("a;b,c\\d\ne")
+	want := `a\;b\,c\\d\ne`
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_foldICSLine(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{name: "75オクテット以下は折り返さない", arg: strings.Repeat("a", 75), want: strings.Repeat("a", 75) + "\r\n"},
+		{name: "75オクテットを超えたら折り返す",
+			arg:  strings.Repeat("a", 150),
+			want: strings.Repeat("a", 75) + "\r\n " + strings.Repeat("a", 74) + "\r\n " + "a" + "\r\n"},
+		{name: "マルチバイト文字の途中では折り返さない",
+			arg:  "SUMMARY:" + strings.Repeat("あ", 30),
+			want: "SUMMARY:" + strings.Repeat("あ", 22) + "\r\n " + strings.Repeat("あ", 8) + "\r\n"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := foldICSLine(test.arg)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_ReadICS(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		arg     string
+		want    []Holiday
+		wantErr error
+	}{
+		{name: "空なら空を返す", arg: "", want: []Holiday{}, wantErr: nil},
+		{name: "終日の予定を休日にする",
+			arg: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART;VALUE=DATE:20210101\r\nDTEND;VALUE=DATE:20210102\r\nSUMMARY:元日\r\nCATEGORIES:祝日\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+			want: []Holiday{
+				{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational},
+			},
+			wantErr: nil},
+		{name: "DTENDがなければ1日の予定",
+			arg: "BEGIN:VEVENT\nDTSTART:20211231\nSUMMARY:休業日\nCATEGORIES:休業日\nEND:VEVENT\n",
+			want: []Holiday{
+				{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日", Kind: HolidayKindExchange},
+			},
+			wantErr: nil},
+		{name: "複数日の予定は1日ずつにする",
+			arg: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20220101\nDTEND;VALUE=DATE:20220104\nSUMMARY:年始休業\nEND:VEVENT\n",
+			want: []Holiday{
+				{Date: time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local), Name: "年始休業"},
+				{Date: time.Date(2022, 1, 2, 0, 0, 0, 0, time.Local), Name: "年始休業"},
+				{Date: time.Date(2022, 1, 3, 0, 0, 0, 0, time.Local), Name: "年始休業"},
+			},
+			wantErr: nil},
+		{name: "折り返しとエスケープを戻す",
+			arg: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20210211\nSUMMARY:建国記念\n の日\\, 祝日\nEND:VEVENT\n",
+			want: []Holiday{
+				{Date: time.Date(2021, 2, 11, 0, 0, 0, 0, time.Local), Name: "建国記念の日, 祝日"},
+			},
+			wantErr: nil},
+		{name: "時刻のある予定は読み飛ばす",
+			arg:     "BEGIN:VEVENT\nDTSTART:20210101T090000Z\nSUMMARY:会議\nEND:VEVENT\n",
+			want:    []Holiday{},
+			wantErr: nil},
+		{name: "VALUE=DATE-TIMEの予定は読み飛ばす",
+			arg:     "BEGIN:VEVENT\nDTSTART;VALUE=DATE-TIME:20210105T090000\nSUMMARY:会議\nEND:VEVENT\nBEGIN:VEVENT\nDTSTART;TZID=Asia/Tokyo;VALUE=DATE-TIME:20210106\nSUMMARY:会議\nEND:VEVENT\n",
+			want:    []Holiday{},
+			wantErr: nil},
+		{name: "取り消された予定は読み飛ばす",
+			arg:     "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20210101\nSUMMARY:元日\nSTATUS:CANCELLED\nEND:VEVENT\n",
+			want:    []Holiday{},
+			wantErr: nil},
+		{name: "入れ子の中のプロパティは使わない",
+			arg: "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20210101\nSUMMARY:元日\nBEGIN:VALARM\nACTION:DISPLAY\nSUMMARY:通知\nEND:VALARM\nEND:VEVENT\n",
+			want: []Holiday{
+				{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日"},
+			},
+			wantErr: nil},
+		{name: "RRULEはエラー",
+			arg:     "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20210101\nRRULE:FREQ=YEARLY\nSUMMARY:元日\nEND:VEVENT\n",
+			want:    nil,
+			wantErr: InvalidArgumentError},
+		{name: "EXDATEはエラー",
+			arg:     "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20220101\nDTEND;VALUE=DATE:20220104\nEXDATE;VALUE=DATE:20220102\nSUMMARY:年始休業\nEND:VEVENT\n",
+			want:    nil,
+			wantErr: InvalidArgumentError},
+		{name: "日付でなければエラー",
+			arg:     "BEGIN:VEVENT\nDTSTART;VALUE=DATE:2021-1-1\nEND:VEVENT\n",
+			want:    nil,
+			wantErr: TimeParseError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ReadICS(strings.NewReader(test.arg))
+			if !reflect.DeepEqual(test.want, got) || !errors.Is(err, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantErr, got, err)
+			}
+		})
+	}
+}
+
+func Test_ReadICS_WriteICS(t *testing.T) {
+	t.Parallel()
+	holidays := []Holiday{
+		{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational},
+		{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: strings.Repeat("長い名前", 10), Kind: HolidayKindExchange},
+	}
+	buf := &bytes.Buffer{}
+	if err := WriteICS(buf, holidays, time.Date(2021, 1, 7, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("%s error: %+v\n", t.Name(), err)
+	}
+	got, err := ReadICS(buf)
+	if !reflect.DeepEqual(holidays, got) || err != nil {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), holidays, got, err)
+	}
+}
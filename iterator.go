@@ -0,0 +1,51 @@
+package jpx_business_day
+
+import "time"
+
+// eachBatch - Eachが1回のロックで取り出す営業日の数
+const eachBatch = 64
+
+// Each - fromからtoまでの営業日を順にfに渡す
+// fromとtoも含み、fromがtoより後なら日付を遡りながら渡す
+// fがfalseを返したらそこで終わる
+// fの中からBusinessDayのメソッドを呼んでもよい
+func (b *businessDay) Each(from, to time.Time, f func(date time.Time) bool) {
+	d, end := dayNumber(from), dayNumber(to)
+	step := int64(1)
+	if d > end {
+		step = -1
+	}
+
+	// dの前日(遡るなら翌日)から数え始めて、d自身が営業日なら最初に渡す
+	d -= step
+	for {
+		dates := b.nextBusinessDays(d, end, step)
+		for _, date := range dates {
+			if !f(date) {
+				return
+			}
+		}
+		if len(dates) < eachBatch {
+			return
+		}
+		d = dayNumber(dates[len(dates)-1])
+	}
+}
+
+// nextBusinessDays - 通し番号dの日の次の営業日からendまでの営業日を最大eachBatch個返す
+// stepが負なら日付を遡る
+func (b *businessDay) nextBusinessDays(d, end, step int64) []time.Time {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	cal := b.calendar()
+	dates := make([]time.Time, 0, eachBatch)
+	for len(dates) < eachBatch {
+		d = cal.add(d, step)
+		if (step > 0 && d > end) || (step < 0 && d < end) {
+			break
+		}
+		dates = append(dates, dateOfNumber(d))
+	}
+	return dates
+}
@@ -0,0 +1,104 @@
+package jpx_business_day
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_businessDay_Each(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local): {{Name: "憲法記念日"}},
+			time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local): {{Name: "みどりの日"}},
+			time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local): {{Name: "こどもの日"}},
+		},
+		lastHoliday: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name  string
+		from  time.Time
+		to    time.Time
+		limit int
+		want  []time.Time
+	}{
+		{name: "fromからtoまでの営業日を順に渡す",
+			from:  time.Date(2021, 4, 30, 10, 0, 0, 0, time.Local),
+			to:    time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local),
+			limit: -1,
+			want: []time.Time{
+				time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local),
+			}},
+		{name: "fromがtoより後なら遡る",
+			from:  time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local),
+			to:    time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			limit: -1,
+			want: []time.Time{
+				time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			}},
+		{name: "falseを返したらそこで終わる",
+			from:  time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+			to:    time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local),
+			limit: 2,
+			want: []time.Time{
+				time.Date(2021, 4, 30, 0, 0, 0, 0, time.Local),
+				time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local),
+			}},
+		{name: "営業日がなければ何も渡さない",
+			from:  time.Date(2021, 5, 1, 0, 0, 0, 0, time.Local),
+			to:    time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local),
+			limit: -1,
+			want:  nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			var got []time.Time
+			bd.Each(test.from, test.to, func(date time.Time) bool {
+				got = append(got, date)
+				return len(got) != test.limit
+			})
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_businessDay_Each_ManyDays(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local): {{Name: "憲法記念日"}},
+			time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local): {{Name: "みどりの日"}},
+			time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local): {{Name: "こどもの日"}},
+		},
+		lastHoliday: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local)}
+	from, to := time.Date(2020, 11, 2, 0, 0, 0, 0, time.Local), time.Date(2022, 3, 31, 0, 0, 0, 0, time.Local)
+
+	// 何回かに分けて取り出しても、1日ずつ判定したのと同じ営業日を渡す
+	var want []time.Time
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		if bd.IsBusinessDay(d) {
+			want = append(want, d)
+		}
+	}
+	var got, gotReverse []time.Time
+	bd.Each(from, to, func(date time.Time) bool {
+		got = append(got, date)
+		return bd.IsBusinessDay(date) // fの中からBusinessDayのメソッドを呼んでもよい
+	})
+	bd.Each(to, from, func(date time.Time) bool {
+		gotReverse = append([]time.Time{date}, gotReverse...)
+		return true
+	})
+	if !reflect.DeepEqual(want, got) || !reflect.DeepEqual(want, gotReverse) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), want, got, gotReverse)
+	}
+}
@@ -0,0 +1,249 @@
+package jpxbdtest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+// NewFake - holidaysだけを休日にする、ネットワークに接続しない営業日情報
+// optsはjbd.NewBusinessDayFromHolidaysに渡す
+func NewFake(holidays []jbd.Holiday, opts ...jbd.Option) *Fake {
+	f := &Fake{holidays: holidays, opts: opts, calls: map[string]int{}}
+	f.rebuild()
+	return f
+}
+
+// Fake - テスト用の営業日情報
+// 休日とRefreshの結果を設定でき、メソッドごとに呼び出された回数を数える
+// 営業日の判定はjbd.NewBusinessDayFromHolidaysで作った営業日情報に委ねる
+type Fake struct {
+	bd                 jbd.BusinessDay
+	holidays           []jbd.Holiday
+	lastUpdateDate     time.Time
+	holidayTradingDays []time.Time
+	refreshErr         error
+	opts               []jbd.Option
+	calls              map[string]int
+	mtx                sync.Mutex
+}
+
+// rebuild - 設定から営業日情報を作り直す
+// 呼び出し側でロックを取っておくこと
+func (f *Fake) rebuild() {
+	opts := append([]jbd.Option{jbd.WithHolidayTradingDays(f.holidayTradingDays)}, f.opts...)
+	f.bd = jbd.NewBusinessDayFromHolidays(f.holidays, f.lastUpdateDate, opts...)
+}
+
+// call - nameの呼び出し回数を数えて、委ねる先の営業日情報を返す
+func (f *Fake) call(name string) jbd.BusinessDay {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.calls[name]++
+	return f.bd
+}
+
+// SetHolidays - 休日をholidaysに置き換える
+func (f *Fake) SetHolidays(holidays []jbd.Holiday) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.holidays = holidays
+	f.rebuild()
+}
+
+// SetLastUpdateDate - LastUpdateDateが返す日付を設定する
+func (f *Fake) SetLastUpdateDate(lastUpdateDate time.Time) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.lastUpdateDate = lastUpdateDate
+	f.rebuild()
+}
+
+// SetRefreshError - Refreshが返すエラーを設定する
+// nilならRefreshは何もせずに成功する
+func (f *Fake) SetRefreshError(err error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.refreshErr = err
+}
+
+// Calls - nameのメソッドが呼び出された回数
+func (f *Fake) Calls(name string) int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	return f.calls[name]
+}
+
+// ResetCalls - 呼び出された回数を0に戻す
+func (f *Fake) ResetCalls() {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.calls = map[string]int{}
+}
+
+// Refresh - SetRefreshErrorで設定したエラーを返す
+// ctxが終わっていればctxのエラーを返し、休日は変えない
+func (f *Fake) Refresh(ctx context.Context) error {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.calls["Refresh"]++
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return f.refreshErr
+}
+
+// LastUpdateDate - SetLastUpdateDateで設定した日付
+func (f *Fake) LastUpdateDate() time.Time {
+	return f.call("LastUpdateDate").LastUpdateDate()
+}
+
+// SetHolidayTradingDays - 祝日取引の実施日を設定する
+// SetHolidaysで休日を置き換えても引き継ぐ
+func (f *Fake) SetHolidayTradingDays(dates []time.Time) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	f.calls["SetHolidayTradingDays"]++
+	f.holidayTradingDays = append([]time.Time{}, dates...)
+	f.bd.SetHolidayTradingDays(f.holidayTradingDays)
+}
+
+// 以降のメソッドは呼び出された回数を数えて、そのまま営業日情報に委ねる
+
+func (f *Fake) IsBusinessDay(target time.Time) bool {
+	return f.call("IsBusinessDay").IsBusinessDay(target)
+}
+
+func (f *Fake) IsHoliday(target time.Time) bool {
+	return f.call("IsHoliday").IsHoliday(target)
+}
+
+func (f *Fake) LastHoliday() time.Time {
+	return f.call("LastHoliday").LastHoliday()
+}
+
+func (f *Fake) IsMarketOpen(target time.Time) bool {
+	return f.call("IsMarketOpen").IsMarketOpen(target)
+}
+
+func (f *Fake) IsDerivativesOpen(target time.Time) bool {
+	return f.call("IsDerivativesOpen").IsDerivativesOpen(target)
+}
+
+func (f *Fake) DerivativesSession(target time.Time) (jbd.Session, time.Time) {
+	return f.call("DerivativesSession").DerivativesSession(target)
+}
+
+func (f *Fake) TradeDate(target time.Time) time.Time {
+	return f.call("TradeDate").TradeDate(target)
+}
+
+func (f *Fake) IsDerivativesTradingDay(target time.Time) bool {
+	return f.call("IsDerivativesTradingDay").IsDerivativesTradingDay(target)
+}
+
+func (f *Fake) AddBusinessDays(target time.Time, n int) time.Time {
+	return f.call("AddBusinessDays").AddBusinessDays(target, n)
+}
+
+func (f *Fake) SettlementDate(tradeDate time.Time, convention jbd.SettlementConvention) (time.Time, error) {
+	return f.call("SettlementDate").SettlementDate(tradeDate, convention)
+}
+
+func (f *Fake) SQDate(year int, month time.Month) time.Time {
+	return f.call("SQDate").SQDate(year, month)
+}
+
+func (f *Fake) LastTradingDay(year int, month time.Month) time.Time {
+	return f.call("LastTradingDay").LastTradingDay(year, month)
+}
+
+func (f *Fake) WeeklyOptionSQDate(year int, month time.Month, week int) (time.Time, error) {
+	return f.call("WeeklyOptionSQDate").WeeklyOptionSQDate(year, month, week)
+}
+
+func (f *Fake) WeeklyOptionLastTradingDay(year int, month time.Month, week int) (time.Time, error) {
+	return f.call("WeeklyOptionLastTradingDay").WeeklyOptionLastTradingDay(year, month, week)
+}
+
+func (f *Fake) RecordDateInfo(recordDate time.Time) jbd.RecordDateInfo {
+	return f.call("RecordDateInfo").RecordDateInfo(recordDate)
+}
+
+func (f *Fake) FirstBusinessDayOfMonth(year int, month time.Month) (time.Time, error) {
+	return f.call("FirstBusinessDayOfMonth").FirstBusinessDayOfMonth(year, month)
+}
+
+func (f *Fake) LastBusinessDayOfMonth(year int, month time.Month) (time.Time, error) {
+	return f.call("LastBusinessDayOfMonth").LastBusinessDayOfMonth(year, month)
+}
+
+func (f *Fake) NthBusinessDayOfMonth(year int, month time.Month, n int) (time.Time, error) {
+	return f.call("NthBusinessDayOfMonth").NthBusinessDayOfMonth(year, month, n)
+}
+
+func (f *Fake) FirstBusinessDayOfQuarter(year int, quarter int) (time.Time, error) {
+	return f.call("FirstBusinessDayOfQuarter").FirstBusinessDayOfQuarter(year, quarter)
+}
+
+func (f *Fake) LastBusinessDayOfQuarter(year int, quarter int) (time.Time, error) {
+	return f.call("LastBusinessDayOfQuarter").LastBusinessDayOfQuarter(year, quarter)
+}
+
+func (f *Fake) NthBusinessDayOfQuarter(year int, quarter int, n int) (time.Time, error) {
+	return f.call("NthBusinessDayOfQuarter").NthBusinessDayOfQuarter(year, quarter, n)
+}
+
+func (f *Fake) FirstBusinessDayOfYear(year int) (time.Time, error) {
+	return f.call("FirstBusinessDayOfYear").FirstBusinessDayOfYear(year)
+}
+
+func (f *Fake) LastBusinessDayOfYear(year int) (time.Time, error) {
+	return f.call("LastBusinessDayOfYear").LastBusinessDayOfYear(year)
+}
+
+func (f *Fake) NthBusinessDayOfYear(year int, n int) (time.Time, error) {
+	return f.call("NthBusinessDayOfYear").NthBusinessDayOfYear(year, n)
+}
+
+func (f *Fake) Each(from, to time.Time, fn func(date time.Time) bool) {
+	f.call("Each").Each(from, to, fn)
+}
+
+func (f *Fake) BusinessDaysBetween(from, to time.Time) int {
+	return f.call("BusinessDaysBetween").BusinessDaysBetween(from, to)
+}
+
+func (f *Fake) Adjust(target time.Time, convention jbd.RollConvention) time.Time {
+	return f.call("Adjust").Adjust(target, convention)
+}
+
+func (f *Fake) Holidays(from, to time.Time) []jbd.Holiday {
+	return f.call("Holidays").Holidays(from, to)
+}
+
+func (f *Fake) Today() time.Time {
+	return f.call("Today").Today()
+}
+
+func (f *Fake) IsTodayBusinessDay() bool {
+	return f.call("IsTodayBusinessDay").IsTodayBusinessDay()
+}
+
+func (f *Fake) IsOpenNow() bool {
+	return f.call("IsOpenNow").IsOpenNow()
+}
+
+func (f *Fake) NextBusinessDayFromNow() time.Time {
+	return f.call("NextBusinessDayFromNow").NextBusinessDayFromNow()
+}
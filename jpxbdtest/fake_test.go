@@ -0,0 +1,90 @@
+package jpxbdtest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+func Test_Fake(t *testing.T) {
+	t.Parallel()
+	var bd jbd.BusinessDay = NewFake(testHolidays)
+	fake := bd.(*Fake)
+
+	if !bd.IsHoliday(time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local)) || bd.IsHoliday(time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("%s error: holidays are not used\n", t.Name())
+	}
+	if want, got := time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local), bd.AddBusinessDays(time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local), 1); !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+	if want, got := 2, fake.Calls("IsHoliday"); want != got {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+
+	fake.SetHolidayTradingDays([]time.Time{time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local)})
+	fake.SetHolidays([]jbd.Holiday{{Date: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local), Name: "こどもの日"}, {Date: time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local), Name: "臨時休業"}})
+	fake.SetLastUpdateDate(testUpdate)
+	if !bd.IsHoliday(time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)) || !bd.IsDerivativesTradingDay(time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local)) || !reflect.DeepEqual(testUpdate, bd.LastUpdateDate()) {
+		t.Errorf("%s error: settings are not used\n", t.Name())
+	}
+
+	fake.ResetCalls()
+	if want, got := 0, fake.Calls("IsHoliday"); want != got {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_Fake_Each(t *testing.T) {
+	t.Parallel()
+	fake := NewFake(testHolidays)
+	var got []time.Time
+	fake.Each(time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local), time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local), func(date time.Time) bool {
+		if fake.IsBusinessDay(date) {
+			got = append(got, date)
+		}
+		return true
+	})
+	want := []time.Time{
+		time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local),
+		time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local),
+		time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local),
+		time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local),
+	}
+	if !reflect.DeepEqual(want, got) || fake.Calls("Each") != 1 {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), want, got, fake.Calls("Each"))
+	}
+}
+
+func Test_Fake_Refresh(t *testing.T) {
+	t.Parallel()
+	refreshErr := errors.New("refresh error")
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		err     error
+		wantErr error
+	}{
+		{name: "設定がなければ成功する", ctx: context.Background(), err: nil, wantErr: nil},
+		{name: "設定したエラーを返す", ctx: context.Background(), err: refreshErr, wantErr: refreshErr},
+		{name: "ctxが終わっていればctxのエラーを返す", ctx: canceled, err: refreshErr, wantErr: context.Canceled},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			fake := NewFake(testHolidays)
+			fake.SetRefreshError(test.err)
+			err := fake.Refresh(test.ctx)
+			if !errors.Is(err, test.wantErr) || fake.Calls("Refresh") != 1 || !fake.IsHoliday(time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local)) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), test.wantErr, err, fake.Calls("Refresh"))
+			}
+		})
+	}
+}
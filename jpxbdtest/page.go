@@ -0,0 +1,140 @@
+// Package jpxbdtest - jpx-business-dayを使うコードのテストを助ける
+//
+// BusinessDayの代わりに使えるFakeと、JPXのページを模したHTMLを返すServerを提供する
+package jpxbdtest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+// Malformation - 壊れたページの壊れ方
+type Malformation int
+
+const (
+	MalformedNone         Malformation = iota // 壊れていない
+	MalformedNoUpdateDate                     // 更新日がない
+	MalformedUpdateDate                       // 更新日が日付として読めない
+	MalformedHolidayDate                      // 休日の日付が日付として読めない
+	MalformedTableLayout                      // 休日の表の構造が変わっている
+	MalformedTruncated                        // 休日の表の途中で切れている
+)
+
+func (m Malformation) String() string {
+	switch m {
+	case MalformedNone:
+		return "壊れていない"
+	case MalformedNoUpdateDate:
+		return "更新日がない"
+	case MalformedUpdateDate:
+		return "更新日が読めない"
+	case MalformedHolidayDate:
+		return "休日の日付が読めない"
+	case MalformedTableLayout:
+		return "休日の表の構造が変わっている"
+	case MalformedTruncated:
+		return "休日の表の途中で切れている"
+	}
+	return "未指定"
+}
+
+var weekdays = []string{"日", "月", "火", "水", "木", "金", "土"}
+
+// Page - JPXのページを模したHTML
+// 休日は年ごとの表に分けて日付順に載せる
+func Page(update time.Time, holidays []jbd.Holiday) string {
+	return MalformedPage(MalformedNone, update, holidays)
+}
+
+// MalformedPage - mの通りに壊したJPXのページを模したHTML
+// 構造を変えた部分のほかはPageと同じになる
+func MalformedPage(m Malformation, update time.Time, holidays []jbd.Holiday) string {
+	sorted := make([]jbd.Holiday, len(holidays))
+	copy(sorted, holidays)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	sb := &strings.Builder{}
+	sb.WriteString(pageHead)
+	switch m {
+	case MalformedNoUpdateDate:
+	case MalformedUpdateDate:
+		// 更新日の形式には合うが、日付としては読めない
+		sb.WriteString("    <li>" + update.Format("2006") + "/13/45 更新</li>\n")
+	default:
+		sb.WriteString("    <li>" + update.Format("2006/01/02") + " 更新</li>\n")
+	}
+	sb.WriteString(pageBody)
+
+	cell := `<td class="a-center">`
+	if m == MalformedTableLayout {
+		cell = `<td class="a-center" style="width: 50%;">`
+	}
+	for i, h := range sorted {
+		if m == MalformedTruncated && i >= len(sorted)/2 {
+			sb.WriteString(`<tr><td class="a-center">` + h.Date.Format("2006/01"))
+			return sb.String()
+		}
+		if i == 0 || sorted[i-1].Date.Year() != h.Date.Year() {
+			if i != 0 {
+				sb.WriteString(tableFoot)
+			}
+			_, _ = fmt.Fprintf(sb, tableHead, i, h.Date.Year())
+		}
+		date := h.Date.Format("2006/01/02")
+		if m == MalformedHolidayDate {
+			date = h.Date.Format("2006/01/") + "32"
+		}
+		_, _ = fmt.Fprintf(sb, "<tr>%s%s（%s）</td>%s%s</td></tr>\n", cell, date, weekdays[h.Date.Weekday()], cell, h.Name)
+	}
+	if len(sorted) > 0 {
+		sb.WriteString(tableFoot)
+	}
+	sb.WriteString(pageFoot)
+	return sb.String()
+}
+
+const pageHead = `<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml" lang="ja" xml:lang="ja">
+<head>
+<meta http-equiv="content-type" content="text/html; charset=utf-8" />
+<title>営業時間・休業日一覧 | 日本取引所グループ</title>
+</head>
+<body>
+  <div id="main-area">
+    <div id="main-areaIn">
+      <div id="read-area">
+  <ul>
+`
+
+const pageBody = `    <li class="icon-print"><a href="javascript:void(0)" onclick="window.print();return false;">印刷</a></li>
+  </ul>
+</div>
+      <div id="readArea">
+        <div><div class="headline-title-wrap"><h1 class="headline-title"><span>営業時間・休業日一覧</span></h1></div></div>
+            <div><h2 class="heading-title-mu" id="heading_0"><span>営業時間</span></h2></div>
+          <div><p class="component-text">8時45分～16時45分（月～金、祝日を除く）</p></div>
+    <div><h2 class="heading-title" id="heading_9"><span>休業日一覧</span></h2></div>
+          <div><p class="component-text">休業日一覧は、国民の祝日に関する法律（祝日法）の改正及びその他祝日に関する特別法の制定等により変更になる場合があります。</p></div>
+`
+
+const tableHead = `    <div><h3 class="subhead-title" id="heading_%d"><span>%d年</span></h3></div>
+          <div><p class="component-text"><div class="component-normal-table">
+<table class="overtable">
+<tr><th width="50%%">日付</th><th width="50%%">名称</th></tr>
+`
+
+const tableFoot = `</table>
+</div></p></div>
+`
+
+const pageFoot = `
+    </div><!-- /readArea -->
+    </div><!-- /main-areaIn -->
+  </div><!-- /main-area -->
+</body>
+</html>
+`
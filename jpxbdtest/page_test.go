@@ -0,0 +1,64 @@
+package jpxbdtest
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+var testHolidays = []jbd.Holiday{
+	{Date: time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: jbd.HolidayKindNational},
+	{Date: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local), Name: "こどもの日", Kind: jbd.HolidayKindNational},
+	{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日", Kind: jbd.HolidayKindExchange},
+	{Date: time.Date(2022, 1, 3, 0, 0, 0, 0, time.Local), Name: "休業日", Kind: jbd.HolidayKindExchange},
+}
+
+var testUpdate = time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local)
+
+func Test_Page(t *testing.T) {
+	t.Parallel()
+	page := Page(testUpdate, testHolidays)
+	wantHolidays := []jbd.Holiday{testHolidays[1], testHolidays[2], testHolidays[0], testHolidays[3]}
+	gotUpdate, gotHolidays, err := jbd.ParsePage(strings.NewReader(page))
+	if !reflect.DeepEqual(testUpdate, gotUpdate) || !reflect.DeepEqual(wantHolidays, gotHolidays) || err != nil {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), testUpdate, wantHolidays, gotUpdate, gotHolidays, err)
+	}
+	for _, want := range []string{"<span>2021年</span>", "<span>2022年</span>", "2021/05/05（水）"} {
+		if !strings.Contains(page, want) {
+			t.Errorf("%s error: %q is not found\n", t.Name(), want)
+		}
+	}
+}
+
+func Test_MalformedPage(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		arg          Malformation
+		wantHolidays int
+		wantErr      error
+		wantErrText  string
+	}{
+		{name: "壊れていなければ全部読める", arg: MalformedNone, wantHolidays: 4, wantErr: nil},
+		{name: "更新日がなければエラー", arg: MalformedNoUpdateDate, wantHolidays: 0, wantErr: jbd.TimeParseError, wantErrText: "not found"},
+		{name: "更新日が読めなければエラー", arg: MalformedUpdateDate, wantHolidays: 0, wantErr: jbd.TimeParseError, wantErrText: "month out of range"},
+		{name: "休日の日付が読めなければ休日がない", arg: MalformedHolidayDate, wantHolidays: 0, wantErr: nil},
+		{name: "表の構造が変わると休日がない", arg: MalformedTableLayout, wantHolidays: 0, wantErr: nil},
+		{name: "途中で切れると前半だけ読める", arg: MalformedTruncated, wantHolidays: 2, wantErr: nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			_, got, err := jbd.ParsePage(strings.NewReader(MalformedPage(test.arg, testUpdate, testHolidays)))
+			if !reflect.DeepEqual(test.wantHolidays, len(got)) || !errors.Is(err, test.wantErr) || (err != nil && !strings.Contains(err.Error(), test.wantErrText)) {
+				t.Errorf("%s error\nwant: %+v, %+v, %q\ngot: %+v, %+v\n", t.Name(), test.wantHolidays, test.wantErr, test.wantErrText, len(got), err)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package jpxbdtest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+// NewServer - pageを返す偽のJPXのサーバーを起動する
+// 使い終わったらCloseすること
+func NewServer(page string) *Server {
+	s := &Server{status: http.StatusOK, page: page}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Server - JPXのページを模したHTMLを返すサーバー
+// jbd.WithURL(s.URL)を指定した営業日情報のRefreshで使う
+type Server struct {
+	*httptest.Server
+	status   int
+	page     string
+	requests int
+	mtx      sync.Mutex
+}
+
+// SetPage - 返すページをpageにする
+func (s *Server) SetPage(page string) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.page = page
+}
+
+// SetStatus - 返すステータスコードをstatusにする
+func (s *Server) SetStatus(status int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.status = status
+}
+
+// Requests - 受け付けたリクエストの数
+func (s *Server) Requests() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.requests
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, _ *http.Request) {
+	s.mtx.Lock()
+	s.requests++
+	status, page := s.status, s.page
+	s.mtx.Unlock()
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(page))
+}
@@ -0,0 +1,40 @@
+package jpxbdtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	jbd "gitlab.com/tsuchinaga/jpx-business-day"
+)
+
+func Test_Server(t *testing.T) {
+	t.Parallel()
+	serv := NewServer(Page(testUpdate, testHolidays))
+	t.Cleanup(serv.Close)
+	bd := jbd.NewBusinessDay(jbd.WithURL(serv.URL))
+
+	if err := bd.Refresh(context.Background()); err != nil {
+		t.Fatalf("%s error: %+v\n", t.Name(), err)
+	}
+	if !reflect.DeepEqual(testUpdate, bd.LastUpdateDate()) || !bd.IsHoliday(time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("%s error: page is not served: %+v\n", t.Name(), bd.LastUpdateDate())
+	}
+
+	serv.SetPage(MalformedPage(MalformedNoUpdateDate, testUpdate, testHolidays))
+	if err := bd.Refresh(context.Background()); !errors.Is(err, jbd.TimeParseError) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), jbd.TimeParseError, err)
+	}
+
+	serv.SetStatus(http.StatusServiceUnavailable)
+	if err := bd.Refresh(context.Background()); !errors.Is(err, jbd.NotOKStatusError) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), jbd.NotOKStatusError, err)
+	}
+
+	if want, got := 3, serv.Requests(); want != got {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
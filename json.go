@@ -0,0 +1,66 @@
+package jpx_business_day
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// jsonHoliday - 休日一覧のJSONの1件
+type jsonHoliday struct {
+	Date   string `json:"date"`
+	Name   string `json:"name"`
+	Kind   string `json:"kind"`
+	Market string `json:"market"`
+	Source string `json:"source"`
+}
+
+// WriteJSON - 休日の一覧をJSONの配列で書き出す
+// 種類と市場はStringの値を書く
+func WriteJSON(w io.Writer, holidays []Holiday, opt FormatOption) error {
+	records := make([]jsonHoliday, 0, len(holidays))
+	for _, h := range holidays {
+		records = append(records, jsonHoliday{
+			Date:   h.Date.Format(opt.dateLayout()),
+			Name:   h.Name,
+			Kind:   h.Kind.String(),
+			Market: h.Market.String(),
+			Source: h.Source,
+		})
+	}
+	b, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	return opt.write(w, append(b, '\n'))
+}
+
+// ReadJSON - WriteJSONの形式のJSONから休日の一覧を読み込む
+func ReadJSON(r io.Reader, opt FormatOption) ([]Holiday, error) {
+	b, err := io.ReadAll(opt.reader(r))
+	if err != nil {
+		return nil, err
+	}
+	var records []jsonHoliday
+	if err := json.Unmarshal(b, &records); err != nil {
+		return nil, err
+	}
+
+	holidays := make([]Holiday, 0, len(records))
+	for i, record := range records {
+		d, err := opt.parseDate(record.Date)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		kind, err := parseHolidayKind(record.Kind)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		market, err := parseMarket(record.Market)
+		if err != nil {
+			return nil, fmt.Errorf("index %d: %w", i, err)
+		}
+		holidays = append(holidays, Holiday{Date: d, Name: record.Name, Kind: kind, Market: market, Source: record.Source})
+	}
+	return holidays, nil
+}
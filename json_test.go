@@ -0,0 +1,101 @@
+package jpx_business_day
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_WriteJSON(t *testing.T) {
+	t.Parallel()
+	holidays := []Holiday{
+		{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational, Source: "jpx"},
+	}
+	tests := []struct {
+		name string
+		arg  []Holiday
+		opt  FormatOption
+		want string
+	}{
+		{name: "空なら空の配列", arg: nil, opt: FormatOption{}, want: "[]\n"},
+		{name: "既定の日付の形式",
+			arg:  holidays,
+			opt:  FormatOption{},
+			want: `[{"date":"2021/01/01","name":"元日","kind":"祝日","market":"未指定","source":"jpx"}]` + "\n"},
+		{name: "日付の形式を指定できる",
+			arg:  holidays,
+			opt:  FormatOption{DateLayout: "2006-01-02"},
+			want: `[{"date":"2021-01-01","name":"元日","kind":"祝日","market":"未指定","source":"jpx"}]` + "\n"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			buf := &bytes.Buffer{}
+			err := WriteJSON(buf, test.arg, test.opt)
+			if !reflect.DeepEqual(test.want, buf.String()) || err != nil {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), test.want, buf.String(), err)
+			}
+		})
+	}
+}
+
+func Test_ReadJSON(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		arg     string
+		opt     FormatOption
+		want    []Holiday
+		wantErr error
+	}{
+		{name: "空の配列なら空を返す", arg: "[]", want: []Holiday{}, wantErr: nil},
+		{name: "省略した項目はゼロ値",
+			arg: `[{"date":"2021/01/01","name":"元日","kind":"祝日","market":"大阪取引所","source":"jpx"},{"date":"2021/12/31","name":"休業日"}]`,
+			want: []Holiday{
+				{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational, Market: MarketOSEDerivatives, Source: "jpx"},
+				{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日"},
+			},
+			wantErr: nil},
+		{name: "日付の形式を指定できる",
+			arg: `[{"date":"2021-01-01","name":"元日"}]`,
+			opt: FormatOption{DateLayout: "2006-01-02"},
+			want: []Holiday{
+				{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日"},
+			},
+			wantErr: nil},
+		{name: "日付の形式が違えばエラー", arg: `[{"date":"2021-01-01","name":"元日"}]`, want: nil, wantErr: TimeParseError},
+		{name: "知らない種類はエラー", arg: `[{"date":"2021/01/01","name":"元日","kind":"national"}]`, want: nil, wantErr: InvalidArgumentError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ReadJSON(strings.NewReader(test.arg), test.opt)
+			if !reflect.DeepEqual(test.want, got) || !errors.Is(err, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantErr, got, err)
+			}
+		})
+	}
+}
+
+func Test_ReadJSON_WriteJSON_ShiftJIS(t *testing.T) {
+	t.Parallel()
+	holidays := []Holiday{
+		{Date: time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational, Market: MarketTOCOM, Source: "jpx"},
+	}
+	opt := FormatOption{Encoding: EncodingShiftJIS}
+	buf := &bytes.Buffer{}
+	if err := WriteJSON(buf, holidays, opt); err != nil {
+		t.Fatalf("%s error: %+v\n", t.Name(), err)
+	}
+	got, err := ReadJSON(buf, opt)
+	if !reflect.DeepEqual(holidays, got) || err != nil {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), holidays, got, err)
+	}
+}
@@ -0,0 +1,28 @@
+package jpx_business_day
+
+// Logger - Refreshとページの読み取りの経過を受け取るロガー
+// argsはキーと値を交互に並べたもので、*slog.Loggerをそのまま渡せる
+// Refreshの中ではロックを取ったまま呼ばれることがあるので、BusinessDayのメソッドを呼ばないこと
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// nopLogger - 何も出力しないロガー
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+
+// log - ロガー
+// 設定されていなければ何も出力しないロガーを返す
+func (b *businessDay) log() Logger {
+	if b.logger == nil {
+		return nopLogger{}
+	}
+	return b.logger
+}
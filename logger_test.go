@@ -0,0 +1,131 @@
+package jpx_business_day
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type testLogger struct {
+	logs []string
+	mtx  sync.Mutex
+}
+
+func (l *testLogger) log(level, msg string) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.logs = append(l.logs, level+" "+msg)
+}
+
+func (l *testLogger) Debug(msg string, _ ...interface{}) { l.log("DEBUG", msg) }
+func (l *testLogger) Info(msg string, _ ...interface{})  { l.log("INFO", msg) }
+func (l *testLogger) Warn(msg string, _ ...interface{})  { l.log("WARN", msg) }
+func (l *testLogger) Error(msg string, _ ...interface{}) { l.log("ERROR", msg) }
+
+func Test_businessDay_Refresh_Logger(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		status   int
+		page     string
+		wantErr  error
+		wantLogs []string
+	}{
+		{name: "成功すれば取得と読み取りと入れ替えを出力する",
+			status:  http.StatusOK,
+			page:    source,
+			wantErr: nil,
+			wantLogs: []string{
+				"DEBUG fetching page",
+				"INFO fetched page",
+				"INFO parsed page",
+				"INFO swapped holidays",
+			}},
+		{name: "ステータスコードが200でなければエラーを出力する",
+			status:  http.StatusNotFound,
+			page:    source,
+			wantErr: NotOKStatusError,
+			wantLogs: []string{
+				"DEBUG fetching page",
+				"INFO fetched page",
+				"ERROR refresh failed",
+			}},
+		{name: "更新日がなければ警告とエラーを出力する",
+			status:  http.StatusOK,
+			page:    "<html></html>",
+			wantErr: TimeParseError,
+			wantLogs: []string{
+				"DEBUG fetching page",
+				"INFO fetched page",
+				"WARN update date is not found",
+				"ERROR refresh failed",
+			}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.page))
+			}))
+			t.Cleanup(serv.Close)
+			logger := &testLogger{}
+			bd := NewBusinessDay(WithURL(serv.URL), WithLogger(logger))
+			err := bd.Refresh(context.Background())
+			if !errors.Is(err, test.wantErr) || !reflect.DeepEqual(test.wantLogs, logger.logs) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantErr, test.wantLogs, err, logger.logs)
+			}
+		})
+	}
+}
+
+func Test_parsePage_Logger(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		arg      string
+		wantLogs []string
+	}{
+		{name: "日付の読めない行と重複した休日は警告する",
+			arg: `<li>2021/01/07 更新</li>
+<tr><td class="a-center">2021/02/30（火）</td><td class="a-center">休業日</td></tr>
+<tr><td class="a-center">2021/12/31（金）</td><td class="a-center">休業日</td></tr>
+<tr><td class="a-center">2021/12/31（金）</td><td class="a-center">休業日</td></tr>`,
+			wantLogs: []string{
+				"WARN skipped holiday with invalid date",
+				"WARN duplicated holiday",
+				"INFO parsed page",
+			}},
+		{name: "休日がなければ警告する",
+			arg: `<li>2021/01/07 更新</li>`,
+			wantLogs: []string{
+				"WARN holidays are not found",
+				"INFO parsed page",
+			}},
+		{name: "更新日が読めなければ警告する",
+			arg: `<li>2021/13/07 更新</li>`,
+			wantLogs: []string{
+				"WARN update date is invalid",
+			}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			logger := &testLogger{}
+			_, _, _ = parsePage(strings.NewReader(test.arg), logger)
+			if !reflect.DeepEqual(test.wantLogs, logger.logs) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.wantLogs, logger.logs)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package jpx_business_day
+
+import "time"
+
+// Market - 市場
+type Market int
+
+const (
+	MarketUnspecified    Market = iota
+	MarketTSECash               // 東京証券取引所 現物市場
+	MarketOSEDerivatives        // 大阪取引所 先物・オプション市場
+	MarketTOCOM                 // 東京商品取引所 商品先物市場
+)
+
+func (m Market) String() string {
+	switch m {
+	case MarketTSECash:
+		return "東京証券取引所"
+	case MarketOSEDerivatives:
+		return "大阪取引所"
+	case MarketTOCOM:
+		return "東京商品取引所"
+	}
+	return "未指定"
+}
+
+// tocomSchedules - 東京商品取引所の商品先物市場の立会時間の変遷
+// 2021/09/21より前の立会時間は持っていない
+var tocomSchedules = []sessionSchedule{
+	{effectiveFrom: time.Date(2021, 9, 21, 0, 0, 0, 0, exchangeLocation), sessions: []Session{
+		{Type: SessionTypeDay, Start: 8*time.Hour + 45*time.Minute, End: 15*time.Hour + 15*time.Minute},
+		{Type: SessionTypeNight, Start: 16*time.Hour + 30*time.Minute, End: 30 * time.Hour},
+	}},
+	// 日中立会の終了を15:45に延長し、夜間立会の開始を17:00に変更
+	{effectiveFrom: time.Date(2024, 11, 5, 0, 0, 0, 0, exchangeLocation), sessions: []Session{
+		{Type: SessionTypeDay, Start: 8*time.Hour + 45*time.Minute, End: 15*time.Hour + 45*time.Minute},
+		{Type: SessionTypeNight, Start: 17 * time.Hour, End: 30 * time.Hour},
+	}},
+}
+
+// NewMarketCalendar - 市場ごとのカレンダーを返す
+// 休日の情報はbdのものを共有するので、bdをRefreshすればカレンダーにも反映される
+// 未対応の市場を指定した場合は立会のないカレンダーになる
+func NewMarketCalendar(market Market, bd BusinessDay) MarketCalendar {
+	mc := &marketCalendar{market: market, businessDay: bd, isTradingDay: bd.IsBusinessDay}
+	switch market {
+	case MarketTSECash:
+		mc.schedules = tseCashSchedules
+	case MarketOSEDerivatives:
+		mc.schedules = oseDerivativesSchedules
+		mc.isTradingDay = bd.IsDerivativesTradingDay
+	case MarketTOCOM:
+		mc.schedules = tocomSchedules
+		mc.isTradingDay = bd.IsDerivativesTradingDay
+	}
+	// 市場を指定した休日はBusinessDayのメソッドでは市場ごとに区別できないので、持っている休日で直接判定する
+	if b, ok := bd.(*businessDay); ok && market != MarketUnspecified {
+		mc.isTradingDay = func(target time.Time) bool {
+			b.mtx.Lock()
+			defer b.mtx.Unlock()
+
+			return b.isTradingDayIn(market, target)
+		}
+	}
+	return mc
+}
+
+type MarketCalendar interface {
+	Market() Market
+	IsTradingDay(target time.Time) bool
+	Sessions(date time.Time) []Session
+	IsOpen(target time.Time) bool
+	Session(target time.Time) (Session, time.Time)
+	TradeDate(target time.Time) time.Time
+	CurrentSession(target time.Time) (Session, bool)
+	NextOpen(target time.Time) time.Time
+	NextClose(target time.Time) time.Time
+	TimeUntilOpen(target time.Time) time.Duration
+}
+
+type marketCalendar struct {
+	market       Market
+	businessDay  BusinessDay
+	schedules    []sessionSchedule
+	isTradingDay func(time.Time) bool
+}
+
+// Market - カレンダーの市場
+func (m *marketCalendar) Market() Market {
+	return m.market
+}
+
+// IsTradingDay - 立会がある日かどうか
+func (m *marketCalendar) IsTradingDay(target time.Time) bool {
+	return m.isTradingDay(target)
+}
+
+// Sessions - dateに始まる立会の一覧
+// 立会がない日は空を返す
+func (m *marketCalendar) Sessions(date time.Time) []Session {
+	if !m.isTradingDay(date) {
+		return []Session{}
+	}
+	return sessionsAt(m.schedules, date)
+}
+
+// IsOpen - 立会時間中かどうか
+func (m *marketCalendar) IsOpen(target time.Time) bool {
+	_, _, ok := sessionAt(m.schedules, m.isTradingDay, target)
+	return ok
+}
+
+// Session - targetで立会中、もしくは次に始まる立会と、その立会の取引日
+// 見つからなければゼロ値を返す
+func (m *marketCalendar) Session(target time.Time) (Session, time.Time) {
+	session, date, ok := nextSession(m.schedules, m.isTradingDay, target)
+	if !ok {
+		return Session{}, time.Time{}
+	}
+	return session, sessionTradeDate(m.businessDay.IsBusinessDay, session, date)
+}
+
+// TradeDate - targetが属する取引日
+// 立会時間外であれば次に始まる立会の取引日を返す
+func (m *marketCalendar) TradeDate(target time.Time) time.Time {
+	_, tradeDate := m.Session(target)
+	return tradeDate
+}
+
+// CurrentSession - targetで立会中の立会
+func (m *marketCalendar) CurrentSession(target time.Time) (Session, bool) {
+	session, _, ok := sessionAt(m.schedules, m.isTradingDay, target)
+	return session, ok
+}
+
+// NextOpen - targetより後で最初に立会が始まる日時
+// 連続する立会は1つの立会として扱い、見つからなければゼロ値を返す
+func (m *marketCalendar) NextOpen(target time.Time) time.Time {
+	var next time.Time
+	m.eachPeriod(target, func(start, end time.Time) bool {
+		if start.After(target) {
+			next = start
+			return false
+		}
+		return true
+	})
+	return next
+}
+
+// NextClose - targetより後で最初に立会が終わる日時
+// 連続する立会は1つの立会として扱い、見つからなければゼロ値を返す
+func (m *marketCalendar) NextClose(target time.Time) time.Time {
+	var next time.Time
+	m.eachPeriod(target, func(start, end time.Time) bool {
+		if end.After(target) {
+			next = end
+			return false
+		}
+		return true
+	})
+	return next
+}
+
+// TimeUntilOpen - 次に立会が始まるまでの時間
+// 立会中なら0を、次の立会が見つからなければ-1を返す
+func (m *marketCalendar) TimeUntilOpen(target time.Time) time.Duration {
+	if m.IsOpen(target) {
+		return 0
+	}
+	next := m.NextOpen(target)
+	if next.IsZero() {
+		return -1
+	}
+	return next.Sub(target)
+}
+
+// eachPeriod - targetの前日から順に、連続する立会をまとめた時間帯をfに渡す
+// fがfalseを返すか、searchLimitDays日分を渡し終えたら終わる
+func (m *marketCalendar) eachPeriod(target time.Time, f func(start, end time.Time) bool) {
+	date := exchangeDate(target)
+	for d := date.AddDate(0, 0, -1); d.Before(date.AddDate(0, 0, searchLimitDays)); d = d.AddDate(0, 0, 1) {
+		if !m.isTradingDay(d) {
+			continue
+		}
+
+		sessions := sessionsAt(m.schedules, d)
+		for i := 0; i < len(sessions); i++ {
+			start, end := sessions[i].StartAt(d), sessions[i].EndAt(d)
+			for i+1 < len(sessions) && sessions[i+1].Start == sessions[i].End {
+				i++
+				end = sessions[i].EndAt(d)
+			}
+			if !f(start, end) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package jpx_business_day
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_marketCalendar_IsTradingDay(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local): {{Name: "秋分の日"}},
+		},
+		lastHoliday: time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local)}
+	WithHolidayTradingDays([]time.Time{time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local)})(bd)
+	tests := []struct {
+		name   string
+		market Market
+		arg    time.Time
+		want   bool
+	}{
+		{name: "現物市場の営業日はtrue", market: MarketTSECash, arg: time.Date(2022, 9, 22, 0, 0, 0, 0, time.Local), want: true},
+		{name: "現物市場は祝日取引の実施日でもfalse", market: MarketTSECash, arg: time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local), want: false},
+		{name: "先物・オプション市場は祝日取引の実施日ならtrue", market: MarketOSEDerivatives, arg: time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local), want: true},
+		{name: "商品先物市場は祝日取引の実施日ならtrue", market: MarketTOCOM, arg: time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local), want: true},
+		{name: "未指定の市場は営業日ならtrue", market: MarketUnspecified, arg: time.Date(2022, 9, 22, 0, 0, 0, 0, time.Local), want: true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewMarketCalendar(test.market, bd).IsTradingDay(test.arg)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_marketCalendar_Sessions(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local): {{Name: "秋分の日"}},
+		},
+		lastHoliday: time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name   string
+		market Market
+		arg    time.Time
+		want   []Session
+	}{
+		{name: "現物市場は前場と後場",
+			market: MarketTSECash,
+			arg:    time.Date(2022, 9, 22, 0, 0, 0, 0, time.Local),
+			want: []Session{
+				{Type: SessionTypeMorning, Start: 9 * time.Hour, End: 11*time.Hour + 30*time.Minute},
+				{Type: SessionTypeAfternoon, Start: 12*time.Hour + 30*time.Minute, End: 15 * time.Hour},
+			}},
+		{name: "商品先物市場は日中立会と夜間立会",
+			market: MarketTOCOM,
+			arg:    time.Date(2022, 9, 22, 0, 0, 0, 0, time.Local),
+			want: []Session{
+				{Type: SessionTypeDay, Start: 8*time.Hour + 45*time.Minute, End: 15*time.Hour + 15*time.Minute},
+				{Type: SessionTypeNight, Start: 16*time.Hour + 30*time.Minute, End: 30 * time.Hour},
+			}},
+		{name: "休業日は空", market: MarketOSEDerivatives, arg: time.Date(2022, 9, 23, 0, 0, 0, 0, time.Local), want: []Session{}},
+		{name: "未指定の市場は空", market: MarketUnspecified, arg: time.Date(2022, 9, 22, 0, 0, 0, 0, time.Local), want: []Session{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewMarketCalendar(test.market, bd).Sessions(test.arg)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_marketCalendar_TradeDate(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{lastHoliday: time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name   string
+		market Market
+		arg    time.Time
+		want   time.Time
+	}{
+		{name: "現物市場は大引け後なら翌営業日",
+			market: MarketTSECash,
+			arg:    time.Date(2022, 9, 30, 15, 0, 0, 0, exchangeLocation),
+			want:   time.Date(2022, 10, 3, 0, 0, 0, 0, time.Local)},
+		{name: "現物市場は立会前なら当日",
+			market: MarketTSECash,
+			arg:    time.Date(2022, 9, 30, 8, 0, 0, 0, exchangeLocation),
+			want:   time.Date(2022, 9, 30, 0, 0, 0, 0, time.Local)},
+		{name: "商品先物市場の夜間立会は翌営業日",
+			market: MarketTOCOM,
+			arg:    time.Date(2022, 9, 30, 20, 0, 0, 0, exchangeLocation),
+			want:   time.Date(2022, 10, 3, 0, 0, 0, 0, time.Local)},
+		{name: "未指定の市場はゼロ値",
+			market: MarketUnspecified,
+			arg:    time.Date(2022, 9, 30, 20, 0, 0, 0, exchangeLocation),
+			want:   time.Time{}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewMarketCalendar(test.market, bd).TradeDate(test.arg)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_marketCalendar_NextOpen_NextClose(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local): {{Name: "憲法記念日"}},
+			time.Date(2021, 5, 4, 0, 0, 0, 0, time.Local): {{Name: "みどりの日"}},
+			time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local): {{Name: "こどもの日"}},
+		},
+		lastHoliday: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name              string
+		market            Market
+		arg               time.Time
+		wantNextOpen      time.Time
+		wantNextClose     time.Time
+		wantTimeUntilOpen time.Duration
+	}{
+		{name: "寄付前は当日の9:00に開く",
+			market:            MarketTSECash,
+			arg:               time.Date(2021, 4, 30, 8, 30, 0, 0, exchangeLocation),
+			wantNextOpen:      time.Date(2021, 4, 30, 9, 0, 0, 0, exchangeLocation),
+			wantNextClose:     time.Date(2021, 4, 30, 11, 30, 0, 0, exchangeLocation),
+			wantTimeUntilOpen: 30 * time.Minute},
+		{name: "昼休み中は後場の開始に開く",
+			market:            MarketTSECash,
+			arg:               time.Date(2021, 4, 30, 11, 45, 0, 0, exchangeLocation),
+			wantNextOpen:      time.Date(2021, 4, 30, 12, 30, 0, 0, exchangeLocation),
+			wantNextClose:     time.Date(2021, 4, 30, 15, 0, 0, 0, exchangeLocation),
+			wantTimeUntilOpen: 45 * time.Minute},
+		{name: "連休前の大引け後は連休明けに開く",
+			market:            MarketTSECash,
+			arg:               time.Date(2021, 4, 30, 15, 0, 0, 0, exchangeLocation),
+			wantNextOpen:      time.Date(2021, 5, 6, 9, 0, 0, 0, exchangeLocation),
+			wantNextClose:     time.Date(2021, 5, 6, 11, 30, 0, 0, exchangeLocation),
+			wantTimeUntilOpen: 5*24*time.Hour + 18*time.Hour},
+		{name: "立会中は次の立会の開始と今の立会の終了",
+			market:            MarketTSECash,
+			arg:               time.Date(2021, 5, 6, 10, 0, 0, 0, exchangeLocation),
+			wantNextOpen:      time.Date(2021, 5, 6, 12, 30, 0, 0, exchangeLocation),
+			wantNextClose:     time.Date(2021, 5, 6, 11, 30, 0, 0, exchangeLocation),
+			wantTimeUntilOpen: 0},
+		{name: "クロージング・オークションは後場とまとめて閉じる",
+			market:            MarketTSECash,
+			arg:               time.Date(2024, 11, 5, 14, 0, 0, 0, exchangeLocation),
+			wantNextOpen:      time.Date(2024, 11, 6, 9, 0, 0, 0, exchangeLocation),
+			wantNextClose:     time.Date(2024, 11, 5, 15, 30, 0, 0, exchangeLocation),
+			wantTimeUntilOpen: 0},
+		{name: "連休前の夜間立会は翌朝に閉じて連休明けに開く",
+			market:            MarketOSEDerivatives,
+			arg:               time.Date(2021, 4, 30, 20, 0, 0, 0, exchangeLocation),
+			wantNextOpen:      time.Date(2021, 5, 6, 8, 45, 0, 0, exchangeLocation),
+			wantNextClose:     time.Date(2021, 5, 1, 5, 30, 0, 0, exchangeLocation),
+			wantTimeUntilOpen: 0},
+		{name: "別のタイムゾーンで渡しても取引所の時刻で開く",
+			market:            MarketTSECash,
+			arg:               time.Date(2021, 4, 30, 8, 30, 0, 0, exchangeLocation).In(time.FixedZone("HST", -10*60*60)),
+			wantNextOpen:      time.Date(2021, 4, 30, 9, 0, 0, 0, exchangeLocation),
+			wantNextClose:     time.Date(2021, 4, 30, 11, 30, 0, 0, exchangeLocation),
+			wantTimeUntilOpen: 30 * time.Minute},
+		{name: "別のタイムゾーンで渡しても取引所の時刻で閉じる",
+			market:            MarketTSECash,
+			arg:               time.Date(2021, 5, 6, 10, 0, 0, 0, exchangeLocation).In(time.FixedZone("HST", -10*60*60)),
+			wantNextOpen:      time.Date(2021, 5, 6, 12, 30, 0, 0, exchangeLocation),
+			wantNextClose:     time.Date(2021, 5, 6, 11, 30, 0, 0, exchangeLocation),
+			wantTimeUntilOpen: 0},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			mc := NewMarketCalendar(test.market, bd)
+			gotNextOpen := mc.NextOpen(test.arg)
+			gotNextClose := mc.NextClose(test.arg)
+			gotTimeUntilOpen := mc.TimeUntilOpen(test.arg)
+			if !reflect.DeepEqual(test.wantNextOpen, gotNextOpen) || !reflect.DeepEqual(test.wantNextClose, gotNextClose) || !reflect.DeepEqual(test.wantTimeUntilOpen, gotTimeUntilOpen) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(),
+					test.wantNextOpen, test.wantNextClose, test.wantTimeUntilOpen, gotNextOpen, gotNextClose, gotTimeUntilOpen)
+			}
+		})
+	}
+}
+
+func Test_marketCalendar_CurrentSession(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{lastHoliday: time.Date(2024, 12, 31, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name   string
+		arg    time.Time
+		want   Session
+		wantOK bool
+	}{
+		{name: "前場中は前場",
+			arg:    time.Date(2024, 11, 5, 9, 0, 0, 0, exchangeLocation),
+			want:   Session{Type: SessionTypeMorning, Start: 9 * time.Hour, End: 11*time.Hour + 30*time.Minute},
+			wantOK: true},
+		{name: "クロージング・オークション中はクロージング・オークション",
+			arg:    time.Date(2024, 11, 5, 15, 27, 0, 0, exchangeLocation),
+			want:   Session{Type: SessionTypeClosingAuction, Start: 15*time.Hour + 25*time.Minute, End: 15*time.Hour + 30*time.Minute},
+			wantOK: true},
+		{name: "立会時間外はfalse", arg: time.Date(2024, 11, 5, 12, 0, 0, 0, exchangeLocation), want: Session{}, wantOK: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, gotOK := NewMarketCalendar(MarketTSECash, bd).CurrentSession(test.arg)
+			if !reflect.DeepEqual(test.want, got) || !reflect.DeepEqual(test.wantOK, gotOK) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantOK, got, gotOK)
+			}
+		})
+	}
+}
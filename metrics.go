@@ -0,0 +1,238 @@
+package jpx_business_day
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Metrics - Refreshの結果を受け取る計測
+// Refreshの中でロックを取ったまま呼ばれるので、BusinessDayのメソッドを呼ばないこと
+type Metrics interface {
+	ObserveRefresh(result RefreshResult)
+}
+
+// RefreshResult - 1回のRefreshの結果
+// 失敗したときの休日情報はRefresh前のままになっている
+type RefreshResult struct {
+	At             time.Time     // Refreshが終わった日時
+	Duration       time.Duration // Refreshにかかった時間
+	Err            error         // 失敗したときのエラー
+	LastUpdateDate time.Time     // ページの更新日
+	LastHoliday    time.Time     // 最終の休日
+	CoveredUntil   time.Time     // 休日情報を持っている最後の日 休日がなければゼロ値
+}
+
+// refreshDurationBuckets - Refreshにかかった時間のヒストグラムの区切り(秒)
+var refreshDurationBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+
+// NewRefreshMetrics - Refreshの結果を集計する計測
+// 残りの日数はclockの今日から数え、clockがnilならtime.Nowを使う
+func NewRefreshMetrics(clock Clock) *RefreshMetrics {
+	return &RefreshMetrics{
+		clock:    clock,
+		failures: map[string]int64{},
+		buckets:  make([]int64, len(refreshDurationBuckets)),
+	}
+}
+
+// RefreshMetrics - Refreshの結果を集計する計測
+// WritePrometheusかServeHTTPでPrometheusのテキスト形式で、StringでJSONで書き出す
+// Stringがあるので、expvar.Publishにそのまま渡せる
+type RefreshMetrics struct {
+	clock          Clock
+	refreshes      int64
+	failures       map[string]int64
+	buckets        []int64
+	durationSum    float64
+	lastRefresh    time.Time
+	lastErr        error
+	lastSuccess    time.Time
+	lastUpdateDate time.Time
+	lastHoliday    time.Time
+	coveredUntil   time.Time
+	mtx            sync.Mutex
+}
+
+// ObserveRefresh - Refreshの結果を集計に加える
+func (m *RefreshMetrics) ObserveRefresh(result RefreshResult) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.refreshes++
+	m.lastRefresh, m.lastErr = result.At, result.Err
+	if result.Err != nil {
+		m.failures[refreshErrorType(result.Err)]++
+	} else {
+		m.lastSuccess = result.At
+	}
+
+	seconds := result.Duration.Seconds()
+	m.durationSum += seconds
+	for i, le := range refreshDurationBuckets {
+		if seconds <= le {
+			m.buckets[i]++
+		}
+	}
+
+	m.lastUpdateDate = result.LastUpdateDate
+	m.lastHoliday = result.LastHoliday
+	m.coveredUntil = result.CoveredUntil
+}
+
+// LastRefresh - 最後に行ったRefreshの日時とエラー
+// まだRefreshしていなければゼロ値とnilを返す
+func (m *RefreshMetrics) LastRefresh() (time.Time, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.lastRefresh, m.lastErr
+}
+
+// refreshSnapshot - ある時点の集計結果
+type refreshSnapshot struct {
+	Refreshes             int64            `json:"refreshTotal"`
+	Failures              map[string]int64 `json:"refreshFailuresTotal"`
+	DurationSum           float64          `json:"refreshDurationSecondsSum"`
+	LastSuccess           int64            `json:"lastRefreshSuccessTimestampSeconds"`
+	LastUpdateDate        int64            `json:"lastUpdateDateTimestampSeconds"`
+	LastHoliday           int64            `json:"lastHolidayTimestampSeconds"`
+	CoverageRemainingDays int64            `json:"coverageRemainingDays"`
+	buckets               []int64
+}
+
+// snapshot - 今の集計結果
+// 休日情報を持っている最後の日が今日より前なら、残りの日数は負になる
+func (m *RefreshMetrics) snapshot() refreshSnapshot {
+	now := time.Now()
+	if m.clock != nil {
+		now = m.clock.Now()
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	s := refreshSnapshot{
+		Refreshes:      m.refreshes,
+		Failures:       map[string]int64{},
+		DurationSum:    m.durationSum,
+		LastSuccess:    unixSeconds(m.lastSuccess),
+		LastUpdateDate: unixSeconds(m.lastUpdateDate),
+		LastHoliday:    unixSeconds(m.lastHoliday),
+		buckets:        append([]int64{}, m.buckets...),
+	}
+	for k, v := range m.failures {
+		s.Failures[k] = v
+	}
+	if !m.coveredUntil.IsZero() {
+		s.CoverageRemainingDays = dayNumber(m.coveredUntil) - dayNumber(now)
+	}
+	return s
+}
+
+// WritePrometheus - 集計結果をPrometheusのテキスト形式で書き出す
+func (m *RefreshMetrics) WritePrometheus(w io.Writer) error {
+	s := m.snapshot()
+	ew := &errWriter{w: w}
+
+	ew.printf("# HELP jpxbd_refresh_total Number of refreshes.\n")
+	ew.printf("# TYPE jpxbd_refresh_total counter\n")
+	ew.printf("jpxbd_refresh_total %d\n", s.Refreshes)
+
+	ew.printf("# HELP jpxbd_refresh_failures_total Number of failed refreshes by error type.\n")
+	ew.printf("# TYPE jpxbd_refresh_failures_total counter\n")
+	types := make([]string, 0, len(s.Failures))
+	for k := range s.Failures {
+		types = append(types, k)
+	}
+	sort.Strings(types)
+	for _, k := range types {
+		ew.printf("jpxbd_refresh_failures_total{type=%q} %d\n", k, s.Failures[k])
+	}
+
+	ew.printf("# HELP jpxbd_refresh_duration_seconds Duration of refreshes.\n")
+	ew.printf("# TYPE jpxbd_refresh_duration_seconds histogram\n")
+	for i, le := range refreshDurationBuckets {
+		ew.printf("jpxbd_refresh_duration_seconds_bucket{le=%q} %d\n", strconv.FormatFloat(le, 'g', -1, 64), s.buckets[i])
+	}
+	ew.printf("jpxbd_refresh_duration_seconds_bucket{le=\"+Inf\"} %d\n", s.Refreshes)
+	ew.printf("jpxbd_refresh_duration_seconds_sum %s\n", strconv.FormatFloat(s.DurationSum, 'g', -1, 64))
+	ew.printf("jpxbd_refresh_duration_seconds_count %d\n", s.Refreshes)
+
+	gauges := []struct {
+		name  string
+		help  string
+		value int64
+	}{
+		{name: "jpxbd_last_refresh_success_timestamp_seconds", help: "Time of the last successful refresh.", value: s.LastSuccess},
+		{name: "jpxbd_last_update_date_timestamp_seconds", help: "Update date of the JPX page.", value: s.LastUpdateDate},
+		{name: "jpxbd_last_holiday_timestamp_seconds", help: "Last holiday on the JPX page.", value: s.LastHoliday},
+		{name: "jpxbd_coverage_remaining_days", help: "Days until the end of the holidays on the JPX page.", value: s.CoverageRemainingDays},
+	}
+	for _, g := range gauges {
+		ew.printf("# HELP %s %s\n", g.name, g.help)
+		ew.printf("# TYPE %s gauge\n", g.name)
+		ew.printf("%s %d\n", g.name, g.value)
+	}
+	return ew.err
+}
+
+// ServeHTTP - 集計結果をPrometheusのテキスト形式で返す
+func (m *RefreshMetrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = m.WritePrometheus(w)
+}
+
+// String - 集計結果のJSON
+func (m *RefreshMetrics) String() string {
+	b, err := json.Marshal(m.snapshot())
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
+
+// refreshErrorType - 集計に使うRefreshのエラーの種類
+func refreshErrorType(err error) string {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, NotOKStatusError):
+		return "not_ok_status"
+	case errors.Is(err, TimeParseError):
+		return "time_parse"
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return "context"
+	case errors.As(err, &netErr):
+		return "network"
+	}
+	return "other"
+}
+
+// unixSeconds - ゼロ値なら0、そうでなければUNIX時間の秒
+func unixSeconds(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// errWriter - 最初のエラーを覚えて、以降の書き込みをしないWriter
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...interface{}) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, args...)
+}
@@ -0,0 +1,192 @@
+package jpx_business_day
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_RefreshMetrics(t *testing.T) {
+	t.Parallel()
+	clock := NewFakeClock(time.Date(2021, 12, 1, 9, 0, 0, 0, time.Local))
+	m := NewRefreshMetrics(clock)
+	m.ObserveRefresh(RefreshResult{
+		At:             time.Date(2021, 12, 1, 6, 0, 0, 0, time.UTC),
+		Duration:       200 * time.Millisecond,
+		LastUpdateDate: time.Date(2021, 1, 7, 0, 0, 0, 0, time.UTC),
+		LastHoliday:    time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC),
+		CoveredUntil:   time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local),
+	})
+	m.ObserveRefresh(RefreshResult{
+		At:             time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC),
+		Duration:       3 * time.Second,
+		Err:            fmt.Errorf("status is 503: %w", NotOKStatusError),
+		LastUpdateDate: time.Date(2021, 1, 7, 0, 0, 0, 0, time.UTC),
+		LastHoliday:    time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC),
+		CoveredUntil:   time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local),
+	})
+
+	want := `# HELP jpxbd_refresh_total Number of refreshes.
+# TYPE jpxbd_refresh_total counter
+jpxbd_refresh_total 2
+# HELP jpxbd_refresh_failures_total Number of failed refreshes by error type.
+# TYPE jpxbd_refresh_failures_total counter
+jpxbd_refresh_failures_total{type="not_ok_status"} 1
+# HELP jpxbd_refresh_duration_seconds Duration of refreshes.
+# TYPE jpxbd_refresh_duration_seconds histogram
+jpxbd_refresh_duration_seconds_bucket{le="0.1"} 0
+jpxbd_refresh_duration_seconds_bucket{le="0.25"} 1
+jpxbd_refresh_duration_seconds_bucket{le="0.5"} 1
+jpxbd_refresh_duration_seconds_bucket{le="1"} 1
+jpxbd_refresh_duration_seconds_bucket{le="2.5"} 1
+jpxbd_refresh_duration_seconds_bucket{le="5"} 2
+jpxbd_refresh_duration_seconds_bucket{le="10"} 2
+jpxbd_refresh_duration_seconds_bucket{le="30"} 2
+jpxbd_refresh_duration_seconds_bucket{le="+Inf"} 2
+jpxbd_refresh_duration_seconds_sum 3.2
+jpxbd_refresh_duration_seconds_count 2
+# HELP jpxbd_last_refresh_success_timestamp_seconds Time of the last successful refresh.
+# TYPE jpxbd_last_refresh_success_timestamp_seconds gauge
+jpxbd_last_refresh_success_timestamp_seconds 1638338400
+# HELP jpxbd_last_update_date_timestamp_seconds Update date of the JPX page.
+# TYPE jpxbd_last_update_date_timestamp_seconds gauge
+jpxbd_last_update_date_timestamp_seconds 1609977600
+# HELP jpxbd_last_holiday_timestamp_seconds Last holiday on the JPX page.
+# TYPE jpxbd_last_holiday_timestamp_seconds gauge
+jpxbd_last_holiday_timestamp_seconds 1640908800
+# HELP jpxbd_coverage_remaining_days Days until the end of the holidays on the JPX page.
+# TYPE jpxbd_coverage_remaining_days gauge
+jpxbd_coverage_remaining_days 30
+`
+	buf := &bytes.Buffer{}
+	if err := m.WritePrometheus(buf); !reflect.DeepEqual(want, buf.String()) || err != nil {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), want, buf.String(), err)
+	}
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if !reflect.DeepEqual(want, rec.Body.String()) || !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain") {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), want, rec.Body.String(), rec.Header())
+	}
+
+	wantJSON := `{"refreshTotal":2,"refreshFailuresTotal":{"not_ok_status":1},"refreshDurationSecondsSum":3.2,` +
+		`"lastRefreshSuccessTimestampSeconds":1638338400,"lastUpdateDateTimestampSeconds":1609977600,` +
+		`"lastHolidayTimestampSeconds":1640908800,"coverageRemainingDays":30}`
+	if got := m.String(); !reflect.DeepEqual(wantJSON, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), wantJSON, got)
+	}
+
+	clock.Set(time.Date(2022, 1, 5, 9, 0, 0, 0, time.Local))
+	if !strings.Contains(m.String(), `"coverageRemainingDays":-5`) {
+		t.Errorf("%s error: coverage is not negative after the end: %s\n", t.Name(), m.String())
+	}
+}
+
+func Test_RefreshMetrics_LastRefresh(t *testing.T) {
+	t.Parallel()
+	m := NewRefreshMetrics(nil)
+	if at, err := m.LastRefresh(); !at.IsZero() || err != nil {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), time.Time{}, nil, at, err)
+	}
+
+	wantErr := fmt.Errorf("status is 503: %w", NotOKStatusError)
+	m.ObserveRefresh(RefreshResult{At: time.Date(2021, 12, 1, 6, 0, 0, 0, time.UTC)})
+	m.ObserveRefresh(RefreshResult{At: time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC), Err: wantErr})
+	want := time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)
+	if at, err := m.LastRefresh(); !reflect.DeepEqual(want, at) || !errors.Is(err, wantErr) {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), want, wantErr, at, err)
+	}
+
+	m.ObserveRefresh(RefreshResult{At: time.Date(2021, 12, 1, 18, 0, 0, 0, time.UTC)})
+	want = time.Date(2021, 12, 1, 18, 0, 0, 0, time.UTC)
+	if at, err := m.LastRefresh(); !reflect.DeepEqual(want, at) || err != nil {
+		t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), want, nil, at, err)
+	}
+}
+
+func Test_RefreshMetrics_Empty(t *testing.T) {
+	t.Parallel()
+	want := `{"refreshTotal":0,"refreshFailuresTotal":{},"refreshDurationSecondsSum":0,` +
+		`"lastRefreshSuccessTimestampSeconds":0,"lastUpdateDateTimestampSeconds":0,` +
+		`"lastHolidayTimestampSeconds":0,"coverageRemainingDays":0}`
+	if got := NewRefreshMetrics(nil).String(); !reflect.DeepEqual(want, got) {
+		t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), want, got)
+	}
+}
+
+func Test_refreshErrorType(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		arg  error
+		want string
+	}{
+		{name: "ステータスコード", arg: fmt.Errorf("status is 404: %w", NotOKStatusError), want: "not_ok_status"},
+		{name: "日付の読み取り", arg: fmt.Errorf("udpate datetime is not found, %w", TimeParseError), want: "time_parse"},
+		{name: "タイムアウト", arg: &url.Error{Op: "Get", URL: "http://localhost/", Err: context.DeadlineExceeded}, want: "context"},
+		{name: "キャンセル", arg: context.Canceled, want: "context"},
+		{name: "ネットワーク", arg: &url.Error{Op: "Get", URL: "http://localhost/", Err: errors.New("connection refused")}, want: "network"},
+		{name: "その他", arg: errors.New("unknown"), want: "other"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := refreshErrorType(test.arg)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+type testMetrics struct {
+	results []RefreshResult
+}
+
+func (m *testMetrics) ObserveRefresh(result RefreshResult) {
+	m.results = append(m.results, result)
+}
+
+func Test_businessDay_Refresh_Metrics(t *testing.T) {
+	t.Parallel()
+	status := http.StatusOK
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(source))
+	}))
+	t.Cleanup(serv.Close)
+	clock := NewFakeClock(time.Date(2021, 12, 1, 9, 0, 0, 0, time.Local))
+	metrics := &testMetrics{}
+	bd := NewBusinessDay(WithURL(serv.URL), WithClock(clock), WithMetrics(metrics))
+
+	if err := bd.Refresh(context.Background()); err != nil {
+		t.Fatalf("%s error: %+v\n", t.Name(), err)
+	}
+	status = http.StatusServiceUnavailable
+	if err := bd.Refresh(context.Background()); !errors.Is(err, NotOKStatusError) {
+		t.Fatalf("%s error: %+v\n", t.Name(), err)
+	}
+
+	if len(metrics.results) != 2 {
+		t.Fatalf("%s error: %d results\n", t.Name(), len(metrics.results))
+	}
+	for i, got := range metrics.results {
+		if !reflect.DeepEqual(clock.Now(), got.At) ||
+			!reflect.DeepEqual(time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local), got.LastUpdateDate) ||
+			!reflect.DeepEqual(time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local), got.LastHoliday) ||
+			!reflect.DeepEqual(time.Date(2022, 12, 31, 0, 0, 0, 0, time.Local), got.CoveredUntil) ||
+			got.Duration <= 0 || (i == 0) != (got.Err == nil) {
+			t.Errorf("%s error: result %d: %+v\n", t.Name(), i, got)
+		}
+	}
+}
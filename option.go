@@ -0,0 +1,45 @@
+package jpx_business_day
+
+import "time"
+
+// Option - 営業日情報を作るときの設定
+type Option func(b *businessDay)
+
+// WithClock - 現在時刻をclockから取るようにする
+// 指定しなければtime.Nowを使う
+func WithClock(clock Clock) Option {
+	return func(b *businessDay) {
+		b.clock = clock
+	}
+}
+
+// WithURL - Refreshで休日の一覧を取得するページをurlにする
+// 指定しなければJPXCalendarURLを使う
+func WithURL(url string) Option {
+	return func(b *businessDay) {
+		b.url = url
+	}
+}
+
+// WithHolidayTradingDays - 祝日取引の実施日を設定する
+// 先物・オプション市場は、実施日なら休日でも立会がある日になる
+// 実施日はRefreshでは変更されず、あとからSetHolidayTradingDaysで差し替えられる
+func WithHolidayTradingDays(dates []time.Time) Option {
+	return func(b *businessDay) {
+		b.setHolidayTradingDays(dates)
+	}
+}
+
+// WithMetrics - Refreshの結果をmetricsに渡すようにする
+func WithMetrics(metrics Metrics) Option {
+	return func(b *businessDay) {
+		b.metrics = metrics
+	}
+}
+
+// WithLogger - Refreshとページの読み取りの経過をloggerに出力する
+func WithLogger(logger Logger) Option {
+	return func(b *businessDay) {
+		b.logger = logger
+	}
+}
@@ -0,0 +1,33 @@
+package jpx_business_day
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_Option(t *testing.T) {
+	t.Parallel()
+	clock := NewFakeClock(time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local))
+	tests := []struct {
+		name      string
+		arg       []Option
+		wantURL   string
+		wantClock Clock
+	}{
+		{name: "指定しなければ既定値", arg: nil, wantURL: JPXCalendarURL, wantClock: nil},
+		{name: "WithClockで時計を指定できる", arg: []Option{WithClock(clock)}, wantURL: JPXCalendarURL, wantClock: clock},
+		{name: "WithURLで取得先を指定できる", arg: []Option{WithURL("http://localhost/")}, wantURL: "http://localhost/", wantClock: nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewBusinessDay(test.arg...).(*businessDay)
+			if !reflect.DeepEqual(test.wantURL, got.url) || !reflect.DeepEqual(test.wantClock, got.clock) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantURL, test.wantClock, got.url, got.clock)
+			}
+		})
+	}
+}
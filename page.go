@@ -0,0 +1,70 @@
+package jpx_business_day
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+)
+
+// JPXCalendarURL - 休日の一覧が載っているJPXのページ
+const JPXCalendarURL = "https://www.jpx.co.jp/corporate/about-jpx/calendar/"
+
+var (
+	updateDateRegexp = regexp.MustCompile(`<li>(\d{4}/\d{2}/\d{2}) 更新</li>`)
+	holidayRegexp    = regexp.MustCompile(`<tr><td class="a-center">(\d{4}/\d{2}/\d{2})\S+</td><td class="a-center">(\S+)</td></tr>`)
+)
+
+// ParsePage - JPXのページのHTMLから、ページの更新日と休日の一覧を読み取る
+// 休日はページに載っている順に返し、日付の読めない行は読み飛ばす
+// 更新日が見つからなければTimeParseErrorを返す
+func ParsePage(r io.Reader) (time.Time, []Holiday, error) {
+	return parsePage(r, nopLogger{})
+}
+
+// parsePage - ParsePageの経過をloggerに出力しながら読み取る
+// 読み飛ばした行や、休日が見つからないなどの疑わしい内容は警告にする
+func parsePage(r io.Reader, logger Logger) (time.Time, []Holiday, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	bodyStr := string(body)
+
+	updateDate := updateDateRegexp.FindAllStringSubmatch(bodyStr, -1)
+	if len(updateDate) < 1 || len(updateDate[0]) < 2 {
+		logger.Warn("update date is not found", "pattern", updateDateRegexp.String(), "bytes", len(body))
+		return time.Time{}, nil, fmt.Errorf("udpate datetime is not found, %w", TimeParseError)
+	}
+	update, err := time.ParseInLocation("2006/01/02", updateDate[0][1], time.Local)
+	if err != nil {
+		logger.Warn("update date is invalid", "value", updateDate[0][1], "error", err)
+		return time.Time{}, nil, fmt.Errorf("%v, %w", err, TimeParseError)
+	}
+
+	rows := holidayRegexp.FindAllStringSubmatch(bodyStr, -1)
+	holidays := make([]Holiday, 0, len(rows))
+	seen := map[time.Time]struct{}{}
+	for _, holiday := range rows {
+		if len(holiday) != 3 {
+			continue
+		}
+
+		t, err := time.ParseInLocation("2006/01/02", holiday[1], time.Local)
+		if err != nil {
+			logger.Warn("skipped holiday with invalid date", "value", holiday[1], "name", holiday[2], "error", err)
+			continue
+		}
+		if _, ok := seen[t]; ok {
+			logger.Warn("duplicated holiday", "date", t, "name", holiday[2])
+		}
+		seen[t] = struct{}{}
+		holidays = append(holidays, Holiday{Date: t, Name: holiday[2], Kind: holidayKind(holiday[2])})
+	}
+
+	if len(holidays) == 0 {
+		logger.Warn("holidays are not found", "pattern", holidayRegexp.String(), "rows", len(rows))
+	}
+	logger.Info("parsed page", "updateDate", update, "bytes", len(body), "rows", len(rows), "holidays", len(holidays))
+	return update, holidays, nil
+}
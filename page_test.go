@@ -0,0 +1,58 @@
+package jpx_business_day
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_ParsePage(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		arg          string
+		wantUpdate   time.Time
+		wantHolidays []Holiday
+		wantErr      error
+	}{
+		{name: "更新日と休日をページの順に読み取る",
+			arg: `<li>2021/01/07 更新</li>
+<tr><td class="a-center">2021/12/31（金）</td><td class="a-center">休業日</td></tr>
+<tr><td class="a-center">2022/01/01（土）</td><td class="a-center">元日</td></tr>`,
+			wantUpdate: time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local),
+			wantHolidays: []Holiday{
+				{Date: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local), Name: "休業日", Kind: HolidayKindExchange},
+				{Date: time.Date(2022, 1, 1, 0, 0, 0, 0, time.Local), Name: "元日", Kind: HolidayKindNational},
+			},
+			wantErr: nil},
+		{name: "日付の読めない行は読み飛ばす",
+			arg: `<li>2021/01/07 更新</li>
+<tr><td class="a-center">2021/02/30（火）</td><td class="a-center">休業日</td></tr>`,
+			wantUpdate:   time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local),
+			wantHolidays: []Holiday{},
+			wantErr:      nil},
+		{name: "更新日がなければエラー",
+			arg:          `<tr><td class="a-center">2022/01/01（土）</td><td class="a-center">元日</td></tr>`,
+			wantUpdate:   time.Time{},
+			wantHolidays: nil,
+			wantErr:      TimeParseError},
+		{name: "更新日が読めなければエラー",
+			arg:          `<li>2021/13/07 更新</li>`,
+			wantUpdate:   time.Time{},
+			wantHolidays: nil,
+			wantErr:      TimeParseError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			gotUpdate, gotHolidays, err := ParsePage(strings.NewReader(test.arg))
+			if !reflect.DeepEqual(test.wantUpdate, gotUpdate) || !reflect.DeepEqual(test.wantHolidays, gotHolidays) || !errors.Is(err, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v\n", t.Name(), test.wantUpdate, test.wantHolidays, test.wantErr, gotUpdate, gotHolidays, err)
+			}
+		})
+	}
+}
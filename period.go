@@ -0,0 +1,110 @@
+package jpx_business_day
+
+import (
+	"fmt"
+	"time"
+)
+
+// FirstBusinessDayOfMonth - 月の最初の営業日
+func (b *businessDay) FirstBusinessDayOfMonth(year int, month time.Month) (time.Time, error) {
+	return b.NthBusinessDayOfMonth(year, month, 1)
+}
+
+// LastBusinessDayOfMonth - 月の最後の営業日
+func (b *businessDay) LastBusinessDayOfMonth(year int, month time.Month) (time.Time, error) {
+	return b.NthBusinessDayOfMonth(year, month, -1)
+}
+
+// NthBusinessDayOfMonth - 月のn番目の営業日
+// nが負なら月末から数えて-n番目の営業日
+func (b *businessDay) NthBusinessDayOfMonth(year int, month time.Month, n int) (time.Time, error) {
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+	return b.nthBusinessDayIn(from, from.AddDate(0, 1, -1), n)
+}
+
+// FirstBusinessDayOfQuarter - 四半期の最初の営業日
+// quarterは1から4で、1月始まりの四半期として扱う
+func (b *businessDay) FirstBusinessDayOfQuarter(year int, quarter int) (time.Time, error) {
+	return b.NthBusinessDayOfQuarter(year, quarter, 1)
+}
+
+// LastBusinessDayOfQuarter - 四半期の最後の営業日
+// quarterは1から4で、1月始まりの四半期として扱う
+func (b *businessDay) LastBusinessDayOfQuarter(year int, quarter int) (time.Time, error) {
+	return b.NthBusinessDayOfQuarter(year, quarter, -1)
+}
+
+// NthBusinessDayOfQuarter - 四半期のn番目の営業日
+// quarterは1から4で、1月始まりの四半期として扱う
+// nが負なら四半期末から数えて-n番目の営業日
+func (b *businessDay) NthBusinessDayOfQuarter(year int, quarter int, n int) (time.Time, error) {
+	if quarter < 1 || 4 < quarter {
+		return time.Time{}, fmt.Errorf("quarter is %d: %w", quarter, InvalidArgumentError)
+	}
+	from := time.Date(year, time.Month(quarter*3-2), 1, 0, 0, 0, 0, time.Local)
+	return b.nthBusinessDayIn(from, from.AddDate(0, 3, -1), n)
+}
+
+// FirstBusinessDayOfYear - 年の最初の営業日(大発会)
+func (b *businessDay) FirstBusinessDayOfYear(year int) (time.Time, error) {
+	return b.NthBusinessDayOfYear(year, 1)
+}
+
+// LastBusinessDayOfYear - 年の最後の営業日(大納会)
+func (b *businessDay) LastBusinessDayOfYear(year int) (time.Time, error) {
+	return b.NthBusinessDayOfYear(year, -1)
+}
+
+// NthBusinessDayOfYear - 年のn番目の営業日
+// nが負なら年末から数えて-n番目の営業日
+func (b *businessDay) NthBusinessDayOfYear(year int, n int) (time.Time, error) {
+	from := time.Date(year, 1, 1, 0, 0, 0, 0, time.Local)
+	return b.nthBusinessDayIn(from, from.AddDate(1, 0, -1), n)
+}
+
+// nthBusinessDayIn - fromからtoまでの期間のn番目の営業日
+// nが負なら期間の最後から数える
+// 期間が取得済みの休日情報の範囲の前後にはみ出していたらエラーを返す
+func (b *businessDay) nthBusinessDayIn(from, to time.Time, n int) (time.Time, error) {
+	if n == 0 {
+		return time.Time{}, fmt.Errorf("n is 0: %w", InvalidArgumentError)
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if coveredUntil := b.coveredUntil(); to.After(coveredUntil) {
+		return time.Time{}, fmt.Errorf("%s is after %s: %w", to.Format("2006/01/02"), coveredUntil.Format("2006/01/02"), OutOfRangeError)
+	}
+	if coveredFrom := b.coveredFrom(); from.Before(coveredFrom) {
+		return time.Time{}, fmt.Errorf("%s is before %s: %w", from.Format("2006/01/02"), coveredFrom.Format("2006/01/02"), OutOfRangeError)
+	}
+
+	start := from.AddDate(0, 0, -1)
+	if n < 0 {
+		start = to.AddDate(0, 0, 1)
+	}
+	d := b.addBusinessDays(start, n)
+	if d.Before(from) || d.After(to) {
+		return time.Time{}, fmt.Errorf("business day %d is not found between %s and %s: %w", n, from.Format("2006/01/02"), to.Format("2006/01/02"), InvalidArgumentError)
+	}
+	return d, nil
+}
+
+// coveredUntil - 休日情報を持っている最後の日
+// JPXのページは年単位で休日を載せているので、最終の休日の年末までとする
+// 呼び出し側でロックを取っておくこと
+func (b *businessDay) coveredUntil() time.Time {
+	return time.Date(b.lastHoliday.Year(), 12, 31, 0, 0, 0, 0, time.Local)
+}
+
+// coveredFrom - 休日情報を持っている最初の日
+// 最初の休日の年初からとし、休日がなければゼロ値を返す
+// 呼び出し側でロックを取っておくこと
+func (b *businessDay) coveredFrom() time.Time {
+	m := b.calendar()
+	if m.days == 0 {
+		return time.Time{}
+	}
+	return dateOfNumber(m.first)
+}
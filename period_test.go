@@ -0,0 +1,116 @@
+package jpx_business_day
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_businessDay_NthBusinessDayOfMonth(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local):   {{Name: "元日"}},
+			time.Date(2021, 1, 2, 0, 0, 0, 0, time.Local):   {{Name: "休業日"}},
+			time.Date(2021, 1, 3, 0, 0, 0, 0, time.Local):   {{Name: "休業日"}},
+			time.Date(2021, 1, 11, 0, 0, 0, 0, time.Local):  {{Name: "成人の日"}},
+			time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): {{Name: "休業日"}},
+		},
+		lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name    string
+		month   time.Month
+		n       int
+		want    time.Time
+		wantErr error
+	}{
+		{name: "1番目は月初の休日を飛ばす", month: time.January, n: 1, want: time.Date(2021, 1, 4, 0, 0, 0, 0, time.Local)},
+		{name: "6番目は祝日を飛ばす", month: time.January, n: 6, want: time.Date(2021, 1, 12, 0, 0, 0, 0, time.Local)},
+		{name: "-1番目は月末の営業日", month: time.January, n: -1, want: time.Date(2021, 1, 29, 0, 0, 0, 0, time.Local)},
+		{name: "-1番目は月末の休業日を飛ばす", month: time.December, n: -1, want: time.Date(2021, 12, 30, 0, 0, 0, 0, time.Local)},
+		{name: "0はエラー", month: time.January, n: 0, wantErr: InvalidArgumentError},
+		{name: "月の営業日数を超えたらエラー", month: time.January, n: 20, wantErr: InvalidArgumentError},
+		{name: "月の営業日数を超えて遡ったらエラー", month: time.January, n: -20, wantErr: InvalidArgumentError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := bd.NthBusinessDayOfMonth(2021, test.month, test.n)
+			if !reflect.DeepEqual(test.want, got) || !errors.Is(err, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantErr, got, err)
+			}
+		})
+	}
+}
+
+func Test_businessDay_PeriodBusinessDays(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.Local):   {{Name: "元日"}},
+			time.Date(2021, 1, 2, 0, 0, 0, 0, time.Local):   {{Name: "休業日"}},
+			time.Date(2021, 1, 3, 0, 0, 0, 0, time.Local):   {{Name: "休業日"}},
+			time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local): {{Name: "休業日"}},
+		},
+		lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name    string
+		f       func() (time.Time, error)
+		want    time.Time
+		wantErr error
+	}{
+		{name: "月初の営業日",
+			f:    func() (time.Time, error) { return bd.FirstBusinessDayOfMonth(2021, time.May) },
+			want: time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local)},
+		{name: "月末の営業日",
+			f:    func() (time.Time, error) { return bd.LastBusinessDayOfMonth(2021, time.July) },
+			want: time.Date(2021, 7, 30, 0, 0, 0, 0, time.Local)},
+		{name: "四半期の最初の営業日",
+			f:    func() (time.Time, error) { return bd.FirstBusinessDayOfQuarter(2021, 3) },
+			want: time.Date(2021, 7, 1, 0, 0, 0, 0, time.Local)},
+		{name: "四半期の最後の営業日",
+			f:    func() (time.Time, error) { return bd.LastBusinessDayOfQuarter(2021, 1) },
+			want: time.Date(2021, 3, 31, 0, 0, 0, 0, time.Local)},
+		{name: "四半期の2番目の営業日",
+			f:    func() (time.Time, error) { return bd.NthBusinessDayOfQuarter(2021, 2, 2) },
+			want: time.Date(2021, 4, 2, 0, 0, 0, 0, time.Local)},
+		{name: "存在しない四半期はエラー",
+			f:       func() (time.Time, error) { return bd.FirstBusinessDayOfQuarter(2021, 5) },
+			wantErr: InvalidArgumentError},
+		{name: "大発会",
+			f:    func() (time.Time, error) { return bd.FirstBusinessDayOfYear(2021) },
+			want: time.Date(2021, 1, 4, 0, 0, 0, 0, time.Local)},
+		{name: "大納会",
+			f:    func() (time.Time, error) { return bd.LastBusinessDayOfYear(2021) },
+			want: time.Date(2021, 12, 30, 0, 0, 0, 0, time.Local)},
+		{name: "年末から2番目の営業日",
+			f:    func() (time.Time, error) { return bd.NthBusinessDayOfYear(2021, -2) },
+			want: time.Date(2021, 12, 29, 0, 0, 0, 0, time.Local)},
+		{name: "休日情報がない年はエラー",
+			f:       func() (time.Time, error) { return bd.FirstBusinessDayOfYear(2022) },
+			wantErr: OutOfRangeError},
+		{name: "休日情報がない月はエラー",
+			f:       func() (time.Time, error) { return bd.LastBusinessDayOfMonth(2022, time.January) },
+			wantErr: OutOfRangeError},
+		{name: "休日情報より前の年はエラー",
+			f:       func() (time.Time, error) { return bd.FirstBusinessDayOfYear(2020) },
+			wantErr: OutOfRangeError},
+		{name: "休日情報より前の月はエラー",
+			f:       func() (time.Time, error) { return bd.LastBusinessDayOfMonth(2020, time.December) },
+			wantErr: OutOfRangeError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := test.f()
+			if !reflect.DeepEqual(test.want, got) || !errors.Is(err, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantErr, got, err)
+			}
+		})
+	}
+}
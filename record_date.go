@@ -0,0 +1,36 @@
+package jpx_business_day
+
+import "time"
+
+// RecordDateInfo - 権利確定日に対する権利付最終日と権利落ち日
+type RecordDateInfo struct {
+	RecordDate        time.Time // 権利確定日
+	LastCumRightsDate time.Time // 権利付最終日
+	ExRightsDate      time.Time // 権利落ち日
+}
+
+// RecordDateInfo - 権利確定日から権利付最終日と権利落ち日を計算する
+// 権利付最終日は受渡日が権利確定日までになる最後の約定日で、約定日によってT+2とT+3を切り替える
+func (b *businessDay) RecordDateInfo(recordDate time.Time) RecordDateInfo {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	record := time.Date(recordDate.Year(), recordDate.Month(), recordDate.Day(), 0, 0, 0, 0, time.Local)
+	last := record
+	if b.isHoliday(last) {
+		last = b.addBusinessDays(last, -1)
+	}
+	for {
+		offset, _ := settlementOffset(last, SettlementConventionAuto)
+		if !b.addBusinessDays(last, offset).After(record) {
+			break
+		}
+		last = b.addBusinessDays(last, -1)
+	}
+
+	return RecordDateInfo{
+		RecordDate:        record,
+		LastCumRightsDate: last,
+		ExRightsDate:      b.addBusinessDays(last, 1),
+	}
+}
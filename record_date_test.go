@@ -0,0 +1,63 @@
+package jpx_business_day
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_businessDay_RecordDateInfo(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2019, 7, 15, 0, 0, 0, 0, time.Local): {{Name: "海の日"}},
+		},
+		lastHoliday: time.Date(2021, 12, 31, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name string
+		arg  time.Time
+		want RecordDateInfo
+	}{
+		{name: "T+2では2営業日前が権利付最終日",
+			arg: time.Date(2021, 3, 31, 0, 0, 0, 0, time.Local),
+			want: RecordDateInfo{
+				RecordDate:        time.Date(2021, 3, 31, 0, 0, 0, 0, time.Local),
+				LastCumRightsDate: time.Date(2021, 3, 29, 0, 0, 0, 0, time.Local),
+				ExRightsDate:      time.Date(2021, 3, 30, 0, 0, 0, 0, time.Local)}},
+		{name: "T+3では3営業日前が権利付最終日",
+			arg: time.Date(2019, 3, 29, 0, 0, 0, 0, time.Local),
+			want: RecordDateInfo{
+				RecordDate:        time.Date(2019, 3, 29, 0, 0, 0, 0, time.Local),
+				LastCumRightsDate: time.Date(2019, 3, 26, 0, 0, 0, 0, time.Local),
+				ExRightsDate:      time.Date(2019, 3, 27, 0, 0, 0, 0, time.Local)}},
+		{name: "T+3からT+2への切り替え直後",
+			arg: time.Date(2019, 7, 18, 0, 0, 0, 0, time.Local),
+			want: RecordDateInfo{
+				RecordDate:        time.Date(2019, 7, 18, 0, 0, 0, 0, time.Local),
+				LastCumRightsDate: time.Date(2019, 7, 16, 0, 0, 0, 0, time.Local),
+				ExRightsDate:      time.Date(2019, 7, 17, 0, 0, 0, 0, time.Local)}},
+		{name: "T+3からT+2への切り替え直前",
+			arg: time.Date(2019, 7, 17, 0, 0, 0, 0, time.Local),
+			want: RecordDateInfo{
+				RecordDate:        time.Date(2019, 7, 17, 0, 0, 0, 0, time.Local),
+				LastCumRightsDate: time.Date(2019, 7, 11, 0, 0, 0, 0, time.Local),
+				ExRightsDate:      time.Date(2019, 7, 12, 0, 0, 0, 0, time.Local)}},
+		{name: "権利確定日が休日なら直前の営業日に受け渡される約定まで",
+			arg: time.Date(2021, 7, 31, 0, 0, 0, 0, time.Local),
+			want: RecordDateInfo{
+				RecordDate:        time.Date(2021, 7, 31, 0, 0, 0, 0, time.Local),
+				LastCumRightsDate: time.Date(2021, 7, 28, 0, 0, 0, 0, time.Local),
+				ExRightsDate:      time.Date(2021, 7, 29, 0, 0, 0, 0, time.Local)}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := bd.RecordDateInfo(test.arg)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package jpx_business_day
+
+import "time"
+
+// SessionType - 立会の種類
+type SessionType int
+
+const (
+	SessionTypeUnspecified    SessionType = iota
+	SessionTypeMorning                    // 前場
+	SessionTypeAfternoon                  // 後場
+	SessionTypeClosingAuction             // クロージング・オークション
+	SessionTypeDay                        // 日中立会
+	SessionTypeNight                      // 夜間立会
+)
+
+func (t SessionType) String() string {
+	switch t {
+	case SessionTypeMorning:
+		return "前場"
+	case SessionTypeAfternoon:
+		return "後場"
+	case SessionTypeClosingAuction:
+		return "クロージング・オークション"
+	case SessionTypeDay:
+		return "日中立会"
+	case SessionTypeNight:
+		return "夜間立会"
+	}
+	return "未指定"
+}
+
+// Session - 立会時間
+// Start, Endは立会が属する日の0時からの経過時間で、Endちょうどの時刻は立会に含まない
+// 夜間立会のように日をまたぐ立会はEndが24時間を超える
+type Session struct {
+	Type  SessionType
+	Start time.Duration
+	End   time.Duration
+}
+
+// StartAt - dateの日の立会として開始日時を返す
+// dateの年月日を取引所の日付として扱い、日本時間の日時を返す
+func (s Session) StartAt(date time.Time) time.Time {
+	return sessionDate(date).Add(s.Start)
+}
+
+// EndAt - dateの日の立会として終了日時を返す
+// dateの年月日を取引所の日付として扱い、日本時間の日時を返す
+func (s Session) EndAt(date time.Time) time.Time {
+	return sessionDate(date).Add(s.End)
+}
+
+// exchangeLocation - 立会時間を表すタイムゾーン
+// time.Localは実行するホストのタイムゾーンなので使わず、夏時間のない日本時間に固定する
+var exchangeLocation = time.FixedZone("JST", 9*60*60)
+
+// exchangeDate - 時刻tを日本時間に直した日付
+// 同じ時刻なら、どのタイムゾーンで渡されても同じ日付になる
+func exchangeDate(t time.Time) time.Time {
+	t = t.In(exchangeLocation)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, exchangeLocation)
+}
+
+// sessionDate - 日付dateの年月日を日本時間の0時にした日付
+func sessionDate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, exchangeLocation)
+}
+
+// sessionSchedule - effectiveFrom以降に適用される立会時間
+type sessionSchedule struct {
+	effectiveFrom time.Time
+	sessions      []Session
+}
+
+// tseCashSchedules - 東証現物市場の立会時間の変遷
+var tseCashSchedules = []sessionSchedule{
+	{effectiveFrom: time.Time{}, sessions: []Session{
+		{Type: SessionTypeMorning, Start: 9 * time.Hour, End: 11 * time.Hour},
+		{Type: SessionTypeAfternoon, Start: 12*time.Hour + 30*time.Minute, End: 15 * time.Hour},
+	}},
+	// 前場の取引終了時刻を11:30に延長
+	{effectiveFrom: time.Date(2011, 11, 21, 0, 0, 0, 0, exchangeLocation), sessions: []Session{
+		{Type: SessionTypeMorning, Start: 9 * time.Hour, End: 11*time.Hour + 30*time.Minute},
+		{Type: SessionTypeAfternoon, Start: 12*time.Hour + 30*time.Minute, End: 15 * time.Hour},
+	}},
+	// 後場の取引終了時刻を15:30に延長し、クロージング・オークションを導入
+	{effectiveFrom: time.Date(2024, 11, 5, 0, 0, 0, 0, exchangeLocation), sessions: []Session{
+		{Type: SessionTypeMorning, Start: 9 * time.Hour, End: 11*time.Hour + 30*time.Minute},
+		{Type: SessionTypeAfternoon, Start: 12*time.Hour + 30*time.Minute, End: 15*time.Hour + 25*time.Minute},
+		{Type: SessionTypeClosingAuction, Start: 15*time.Hour + 25*time.Minute, End: 15*time.Hour + 30*time.Minute},
+	}},
+}
+
+// CashSessions - dateに適用される東証現物市場の立会時間
+// 休業日かどうかは考慮しない
+func CashSessions(date time.Time) []Session {
+	return sessionsAt(tseCashSchedules, date)
+}
+
+// sessionsAt - dateに適用される立会時間をschedulesから探す
+func sessionsAt(schedules []sessionSchedule, date time.Time) []Session {
+	target := sessionDate(date)
+	var sessions []Session
+	for _, schedule := range schedules {
+		if target.Before(schedule.effectiveFrom) {
+			break
+		}
+		sessions = schedule.sessions
+	}
+	return append([]Session{}, sessions...)
+}
+
+// sessionAt - targetを含む立会と、その立会が属する日を返す
+// 日をまたぐ立会があるので前日の立会から確認する
+func sessionAt(schedules []sessionSchedule, isTradingDay func(time.Time) bool, target time.Time) (Session, time.Time, bool) {
+	date := exchangeDate(target)
+	for _, d := range []time.Time{date.AddDate(0, 0, -1), date} {
+		if !isTradingDay(d) {
+			continue
+		}
+		for _, s := range sessionsAt(schedules, d) {
+			if !target.Before(s.StartAt(d)) && target.Before(s.EndAt(d)) {
+				return s, d, true
+			}
+		}
+	}
+	return Session{}, time.Time{}, false
+}
+
+// searchLimitDays - 次の立会や営業日を探すときに遡ったり進んだりする最大日数
+const searchLimitDays = 366
+
+// nextSession - targetで立会中、もしくはtarget以降に最初に始まる立会と、その立会が属する日を返す
+func nextSession(schedules []sessionSchedule, isTradingDay func(time.Time) bool, target time.Time) (Session, time.Time, bool) {
+	date := exchangeDate(target)
+	for d := date.AddDate(0, 0, -1); d.Before(date.AddDate(0, 0, searchLimitDays)); d = d.AddDate(0, 0, 1) {
+		if !isTradingDay(d) {
+			continue
+		}
+		for _, s := range sessionsAt(schedules, d) {
+			if target.Before(s.EndAt(d)) {
+				return s, d, true
+			}
+		}
+	}
+	return Session{}, time.Time{}, false
+}
+
+// sessionTradeDate - dateに始まるsessionの取引日
+// 夜間立会と休日に行われる立会は翌営業日の取引日に属する
+// 取引日はほかの日付と同じくtime.Localの日付で返す
+func sessionTradeDate(isBusinessDay func(time.Time) bool, session Session, date time.Time) time.Time {
+	d := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+	if session.Type != SessionTypeNight && isBusinessDay(d) {
+		return d
+	}
+	for i := 0; i < searchLimitDays; i++ {
+		d = d.AddDate(0, 0, 1)
+		if isBusinessDay(d) {
+			return d
+		}
+	}
+	return time.Time{}
+}
+
+// IsMarketOpen - 東証現物市場の立会時間中かどうか
+func (b *businessDay) IsMarketOpen(target time.Time) bool {
+	return NewMarketCalendar(MarketTSECash, b).IsOpen(target)
+}
@@ -0,0 +1,121 @@
+package jpx_business_day
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_CashSessions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		arg  time.Time
+		want []Session
+	}{
+		{name: "2011/11/21より前は前場が11:00まで",
+			arg: time.Date(2011, 11, 18, 0, 0, 0, 0, time.Local),
+			want: []Session{
+				{Type: SessionTypeMorning, Start: 9 * time.Hour, End: 11 * time.Hour},
+				{Type: SessionTypeAfternoon, Start: 12*time.Hour + 30*time.Minute, End: 15 * time.Hour},
+			}},
+		{name: "2011/11/21からは前場が11:30まで",
+			arg: time.Date(2011, 11, 21, 0, 0, 0, 0, time.Local),
+			want: []Session{
+				{Type: SessionTypeMorning, Start: 9 * time.Hour, End: 11*time.Hour + 30*time.Minute},
+				{Type: SessionTypeAfternoon, Start: 12*time.Hour + 30*time.Minute, End: 15 * time.Hour},
+			}},
+		{name: "2024/11/01は後場が15:00まで",
+			arg: time.Date(2024, 11, 1, 15, 0, 0, 0, exchangeLocation),
+			want: []Session{
+				{Type: SessionTypeMorning, Start: 9 * time.Hour, End: 11*time.Hour + 30*time.Minute},
+				{Type: SessionTypeAfternoon, Start: 12*time.Hour + 30*time.Minute, End: 15 * time.Hour},
+			}},
+		{name: "2024/11/05からはクロージング・オークションを含めて15:30まで",
+			arg: time.Date(2024, 11, 5, 0, 0, 0, 0, time.Local),
+			want: []Session{
+				{Type: SessionTypeMorning, Start: 9 * time.Hour, End: 11*time.Hour + 30*time.Minute},
+				{Type: SessionTypeAfternoon, Start: 12*time.Hour + 30*time.Minute, End: 15*time.Hour + 25*time.Minute},
+				{Type: SessionTypeClosingAuction, Start: 15*time.Hour + 25*time.Minute, End: 15*time.Hour + 30*time.Minute},
+			}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := CashSessions(test.arg)
+			if !reflect.DeepEqual(test.want, got) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v\n", t.Name(), test.want, got)
+			}
+		})
+	}
+}
+
+func Test_marketCalendar_IsOpen_TSECash(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local): {{Name: "こどもの日"}},
+		},
+		lastHoliday: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name string
+		arg  time.Time
+		want bool
+	}{
+		{name: "寄付前はfalse", arg: time.Date(2021, 5, 6, 8, 59, 59, 0, exchangeLocation), want: false},
+		{name: "9:00はtrue", arg: time.Date(2021, 5, 6, 9, 0, 0, 0, exchangeLocation), want: true},
+		{name: "前場引けの11:30はfalse", arg: time.Date(2021, 5, 6, 11, 30, 0, 0, exchangeLocation), want: false},
+		{name: "後場中はtrue", arg: time.Date(2021, 5, 6, 14, 59, 59, 0, exchangeLocation), want: true},
+		{name: "2021年の15:00はfalse", arg: time.Date(2021, 5, 6, 15, 0, 0, 0, exchangeLocation), want: false},
+		{name: "2024/11/05以降の15:10はtrue", arg: time.Date(2024, 11, 5, 15, 10, 0, 0, exchangeLocation), want: true},
+		{name: "2024/11/05以降のクロージング・オークション中はtrue", arg: time.Date(2024, 11, 5, 15, 29, 0, 0, exchangeLocation), want: true},
+		{name: "2024/11/05以降の15:30はfalse", arg: time.Date(2024, 11, 5, 15, 30, 0, 0, exchangeLocation), want: false},
+		{name: "祝日はfalse", arg: time.Date(2021, 5, 5, 10, 0, 0, 0, exchangeLocation), want: false},
+		{name: "土曜日はfalse", arg: time.Date(2021, 5, 8, 10, 0, 0, 0, exchangeLocation), want: false},
+		{name: "別のタイムゾーンで渡しても同じ時刻ならtrue", arg: time.Date(2021, 5, 6, 10, 0, 0, 0, exchangeLocation).In(time.FixedZone("HST", -10*60*60)), want: true},
+		{name: "別のタイムゾーンで渡しても寄付前ならfalse", arg: time.Date(2021, 5, 6, 8, 0, 0, 0, exchangeLocation).In(time.FixedZone("HST", -10*60*60)), want: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewMarketCalendar(MarketTSECash, bd).IsOpen(test.arg)
+			gotMarketOpen := bd.IsMarketOpen(test.arg)
+			if !reflect.DeepEqual(test.want, got) || !reflect.DeepEqual(test.want, gotMarketOpen) {
+				t.Errorf("%s error\nwant: %+v\ngot: %+v, %+v\n", t.Name(), test.want, got, gotMarketOpen)
+			}
+		})
+	}
+}
+
+// time.Localを書き換えるので、並行に実行するテストが止まっている間に実行する
+func Test_marketCalendar_NotJSTLocal(t *testing.T) {
+	local := time.Local
+	t.Cleanup(func() { time.Local = local })
+
+	for _, loc := range []*time.Location{time.UTC, time.FixedZone("HST", -10*60*60), time.FixedZone("NZST", 12*60*60)} {
+		time.Local = loc
+		bd := NewBusinessDayFromHolidays([]Holiday{
+			{Date: time.Date(2021, 5, 5, 0, 0, 0, 0, time.Local), Name: "こどもの日"},
+		}, time.Time{})
+		mc := NewMarketCalendar(MarketTSECash, bd)
+
+		if got := mc.IsOpen(time.Date(2021, 5, 6, 10, 0, 0, 0, exchangeLocation)); !got {
+			t.Errorf("%s error: IsOpen in %s\nwant: %+v\ngot: %+v\n", t.Name(), loc, true, got)
+		}
+		if got := mc.IsOpen(time.Date(2021, 5, 5, 10, 0, 0, 0, exchangeLocation)); got {
+			t.Errorf("%s error: IsOpen on holiday in %s\nwant: %+v\ngot: %+v\n", t.Name(), loc, false, got)
+		}
+		want := time.Date(2021, 5, 6, 9, 0, 0, 0, exchangeLocation)
+		if got := mc.NextOpen(time.Date(2021, 5, 6, 8, 0, 0, 0, exchangeLocation)); !reflect.DeepEqual(want, got) {
+			t.Errorf("%s error: NextOpen in %s\nwant: %+v\ngot: %+v\n", t.Name(), loc, want, got)
+		}
+		want = time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local)
+		if got := mc.TradeDate(time.Date(2021, 5, 6, 16, 0, 0, 0, exchangeLocation)); !reflect.DeepEqual(want, got) {
+			t.Errorf("%s error: TradeDate in %s\nwant: %+v\ngot: %+v\n", t.Name(), loc, want, got)
+		}
+	}
+}
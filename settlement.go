@@ -0,0 +1,65 @@
+package jpx_business_day
+
+import (
+	"fmt"
+	"time"
+)
+
+// SettlementConvention - 受渡日の計算方法
+// ゼロ値はSettlementConventionAutoになる
+// 任意の営業日数はSettlementConventionTPlusで指定する
+type SettlementConvention int
+
+const (
+	SettlementConventionAuto SettlementConvention = iota // 約定日によってT+2とT+3を切り替える
+	settlementConventionT0                               // 約定日当日 T+nはこの値にnを足して表す
+)
+
+const (
+	SettlementConventionT2 = settlementConventionT0 + 2 // 約定日から2営業日目
+	SettlementConventionT3 = settlementConventionT0 + 3 // 約定日から3営業日目
+)
+
+// SettlementConventionTPlus - 約定日からn営業日目を受渡日にする計算方法
+// nが負なら不正な計算方法になり、SettlementDateはエラーを返す
+func SettlementConventionTPlus(n int) SettlementConvention {
+	if n < 0 {
+		return SettlementConventionAuto - 1
+	}
+	return settlementConventionT0 + SettlementConvention(n)
+}
+
+// t2EffectiveDate - この日以降の約定からT+2になる
+var t2EffectiveDate = time.Date(2019, 7, 16, 0, 0, 0, 0, time.Local)
+
+// settlementOffset - tradeDateの約定に適用する営業日数
+func settlementOffset(tradeDate time.Time, convention SettlementConvention) (int, error) {
+	switch {
+	case convention == SettlementConventionAuto:
+		d := time.Date(tradeDate.Year(), tradeDate.Month(), tradeDate.Day(), 0, 0, 0, 0, time.Local)
+		if d.Before(t2EffectiveDate) {
+			return int(SettlementConventionT3 - settlementConventionT0), nil
+		}
+		return int(SettlementConventionT2 - settlementConventionT0), nil
+	case convention >= settlementConventionT0:
+		return int(convention - settlementConventionT0), nil
+	}
+	return 0, fmt.Errorf("convention is %d: %w", convention, InvalidArgumentError)
+}
+
+// SettlementDate - 約定日から受渡日を計算する
+// 約定日が営業日でなければエラーを返す
+func (b *businessDay) SettlementDate(tradeDate time.Time, convention SettlementConvention) (time.Time, error) {
+	offset, err := settlementOffset(tradeDate, convention)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.isHoliday(tradeDate) {
+		return time.Time{}, fmt.Errorf("trade date is %s: %w", tradeDate.Format("2006/01/02"), NotBusinessDayError)
+	}
+	return b.addBusinessDays(tradeDate, offset), nil
+}
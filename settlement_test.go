@@ -0,0 +1,76 @@
+package jpx_business_day
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_businessDay_SettlementDate(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2019, 7, 15, 0, 0, 0, 0, time.Local): {{Name: "海の日"}},
+		},
+		lastHoliday: time.Date(2019, 7, 15, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name       string
+		tradeDate  time.Time
+		convention SettlementConvention
+		want       time.Time
+		wantErr    error
+	}{
+		{name: "2019/07/16より前の約定はT+3",
+			tradeDate:  time.Date(2019, 7, 11, 0, 0, 0, 0, time.Local),
+			convention: SettlementConventionAuto,
+			want:       time.Date(2019, 7, 17, 0, 0, 0, 0, time.Local)},
+		{name: "T+3最後の約定日は休日をまたぐ",
+			tradeDate:  time.Date(2019, 7, 12, 0, 0, 0, 0, time.Local),
+			convention: SettlementConventionAuto,
+			want:       time.Date(2019, 7, 18, 0, 0, 0, 0, time.Local)},
+		{name: "2019/07/16以降の約定はT+2",
+			tradeDate:  time.Date(2019, 7, 16, 0, 0, 0, 0, time.Local),
+			convention: SettlementConventionAuto,
+			want:       time.Date(2019, 7, 18, 0, 0, 0, 0, time.Local)},
+		{name: "T+2を指定すれば2019/07/16より前でもT+2",
+			tradeDate:  time.Date(2019, 7, 11, 0, 0, 0, 0, time.Local),
+			convention: SettlementConventionT2,
+			want:       time.Date(2019, 7, 16, 0, 0, 0, 0, time.Local)},
+		{name: "T+3を指定すれば2019/07/16以降でもT+3",
+			tradeDate:  time.Date(2019, 7, 16, 0, 0, 0, 0, time.Local),
+			convention: SettlementConventionT3,
+			want:       time.Date(2019, 7, 19, 0, 0, 0, 0, time.Local)},
+		{name: "ゼロ値は約定日によって切り替える",
+			tradeDate:  time.Date(2019, 7, 11, 0, 0, 0, 0, time.Local),
+			convention: SettlementConvention(0),
+			want:       time.Date(2019, 7, 17, 0, 0, 0, 0, time.Local)},
+		{name: "任意の営業日数も指定できる",
+			tradeDate:  time.Date(2019, 7, 12, 0, 0, 0, 0, time.Local),
+			convention: SettlementConventionTPlus(1),
+			want:       time.Date(2019, 7, 16, 0, 0, 0, 0, time.Local)},
+		{name: "T+0なら約定日当日",
+			tradeDate:  time.Date(2019, 7, 12, 0, 0, 0, 0, time.Local),
+			convention: SettlementConventionTPlus(0),
+			want:       time.Date(2019, 7, 12, 0, 0, 0, 0, time.Local)},
+		{name: "約定日が営業日でなければエラー",
+			tradeDate:  time.Date(2019, 7, 15, 0, 0, 0, 0, time.Local),
+			convention: SettlementConventionAuto,
+			wantErr:    NotBusinessDayError},
+		{name: "不正な計算方法ならエラー",
+			tradeDate:  time.Date(2019, 7, 16, 0, 0, 0, 0, time.Local),
+			convention: SettlementConventionTPlus(-1),
+			wantErr:    InvalidArgumentError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := bd.SettlementDate(test.tradeDate, test.convention)
+			if !reflect.DeepEqual(test.want, got) || !errors.Is(err, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.want, test.wantErr, got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package jpx_business_day
+
+import (
+	"fmt"
+	"time"
+)
+
+// SQDate - 限月のSQ日
+// 第2金曜日で、休業日であればその前の営業日になる
+// 先物と月次のオプションで共通
+func (b *businessDay) SQDate(year int, month time.Month) time.Time {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	d, _ := nthFriday(year, month, 2)
+	return b.sqDate(d)
+}
+
+// LastTradingDay - 限月の取引最終日
+// SQ日の前営業日で、先物と月次のオプションで共通
+func (b *businessDay) LastTradingDay(year int, month time.Month) time.Time {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	d, _ := nthFriday(year, month, 2)
+	return b.addBusinessDays(b.sqDate(d), -1)
+}
+
+// WeeklyOptionSQDate - 週次のオプションのSQ日
+// weekは何番目の金曜日かで、第2金曜日は月次のオプションになるので指定できない
+func (b *businessDay) WeeklyOptionSQDate(year int, month time.Month, week int) (time.Time, error) {
+	d, err := weeklyOptionFriday(year, month, week)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.sqDate(d), nil
+}
+
+// WeeklyOptionLastTradingDay - 週次のオプションの取引最終日
+// weekは何番目の金曜日かで、第2金曜日は月次のオプションになるので指定できない
+func (b *businessDay) WeeklyOptionLastTradingDay(year int, month time.Month, week int) (time.Time, error) {
+	d, err := weeklyOptionFriday(year, month, week)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.addBusinessDays(b.sqDate(d), -1), nil
+}
+
+// sqDate - 本来のSQ日が休業日なら前の営業日にずらす
+// 呼び出し側でロックを取っておくこと
+func (b *businessDay) sqDate(d time.Time) time.Time {
+	if b.isHoliday(d) {
+		return b.addBusinessDays(d, -1)
+	}
+	return d
+}
+
+// weeklyOptionFriday - 週次のオプションの本来のSQ日
+func weeklyOptionFriday(year int, month time.Month, week int) (time.Time, error) {
+	if week == 2 {
+		return time.Time{}, fmt.Errorf("week 2 is monthly option: %w", InvalidArgumentError)
+	}
+	d, ok := nthFriday(year, month, week)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%d/%02d has no friday of week %d: %w", year, month, week, InvalidArgumentError)
+	}
+	return d, nil
+}
+
+// nthFriday - 月のn番目の金曜日
+// その月にn番目の金曜日がなければfalseを返す
+func nthFriday(year int, month time.Month, n int) (time.Time, bool) {
+	if n < 1 {
+		return time.Time{}, false
+	}
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.Local)
+	d := first.AddDate(0, 0, (int(time.Friday)-int(first.Weekday())+7)%7+7*(n-1))
+	if d.Month() != month {
+		return time.Time{}, false
+	}
+	return d, true
+}
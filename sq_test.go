@@ -0,0 +1,108 @@
+package jpx_business_day
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_businessDay_SQDate(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 2, 11, 0, 0, 0, 0, time.Local): {{Name: "建国記念の日"}},
+			time.Date(2021, 2, 12, 0, 0, 0, 0, time.Local): {{Name: "休業日"}},
+			time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local):  {{Name: "憲法記念日"}},
+		},
+		lastHoliday: time.Date(2021, 5, 3, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name               string
+		year               int
+		month              time.Month
+		wantSQDate         time.Time
+		wantLastTradingDay time.Time
+	}{
+		{name: "第2金曜日がSQ日で前営業日が取引最終日",
+			year:               2021,
+			month:              time.May,
+			wantSQDate:         time.Date(2021, 5, 14, 0, 0, 0, 0, time.Local),
+			wantLastTradingDay: time.Date(2021, 5, 13, 0, 0, 0, 0, time.Local)},
+		{name: "第2金曜日が休業日なら前の営業日にずれる",
+			year:               2021,
+			month:              time.February,
+			wantSQDate:         time.Date(2021, 2, 10, 0, 0, 0, 0, time.Local),
+			wantLastTradingDay: time.Date(2021, 2, 9, 0, 0, 0, 0, time.Local)},
+		{name: "1日が金曜日なら8日が第2金曜日",
+			year:               2021,
+			month:              time.January,
+			wantSQDate:         time.Date(2021, 1, 8, 0, 0, 0, 0, time.Local),
+			wantLastTradingDay: time.Date(2021, 1, 7, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			gotSQDate := bd.SQDate(test.year, test.month)
+			gotLastTradingDay := bd.LastTradingDay(test.year, test.month)
+			if !reflect.DeepEqual(test.wantSQDate, gotSQDate) || !reflect.DeepEqual(test.wantLastTradingDay, gotLastTradingDay) {
+				t.Errorf("%s error\nwant: %+v, %+v\ngot: %+v, %+v\n", t.Name(), test.wantSQDate, test.wantLastTradingDay, gotSQDate, gotLastTradingDay)
+			}
+		})
+	}
+}
+
+func Test_businessDay_WeeklyOptionSQDate(t *testing.T) {
+	t.Parallel()
+	bd := &businessDay{
+		holidays: map[time.Time][]Holiday{
+			time.Date(2021, 7, 23, 0, 0, 0, 0, time.Local): {{Name: "スポーツの日"}},
+		},
+		lastHoliday: time.Date(2021, 7, 23, 0, 0, 0, 0, time.Local)}
+	tests := []struct {
+		name               string
+		year               int
+		month              time.Month
+		week               int
+		wantSQDate         time.Time
+		wantLastTradingDay time.Time
+		wantErr            error
+	}{
+		{name: "第1金曜日",
+			year:               2021,
+			month:              time.May,
+			week:               1,
+			wantSQDate:         time.Date(2021, 5, 7, 0, 0, 0, 0, time.Local),
+			wantLastTradingDay: time.Date(2021, 5, 6, 0, 0, 0, 0, time.Local)},
+		{name: "休業日なら前の営業日にずれる",
+			year:               2021,
+			month:              time.July,
+			week:               4,
+			wantSQDate:         time.Date(2021, 7, 22, 0, 0, 0, 0, time.Local),
+			wantLastTradingDay: time.Date(2021, 7, 21, 0, 0, 0, 0, time.Local)},
+		{name: "第5金曜日がある月は指定できる",
+			year:               2021,
+			month:              time.July,
+			week:               5,
+			wantSQDate:         time.Date(2021, 7, 30, 0, 0, 0, 0, time.Local),
+			wantLastTradingDay: time.Date(2021, 7, 29, 0, 0, 0, 0, time.Local)},
+		{name: "第5金曜日がない月はエラー", year: 2021, month: time.May, week: 5, wantErr: InvalidArgumentError},
+		{name: "第2金曜日は月次なのでエラー", year: 2021, month: time.May, week: 2, wantErr: InvalidArgumentError},
+		{name: "0はエラー", year: 2021, month: time.May, week: 0, wantErr: InvalidArgumentError},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			gotSQDate, err1 := bd.WeeklyOptionSQDate(test.year, test.month, test.week)
+			gotLastTradingDay, err2 := bd.WeeklyOptionLastTradingDay(test.year, test.month, test.week)
+			if !reflect.DeepEqual(test.wantSQDate, gotSQDate) || !reflect.DeepEqual(test.wantLastTradingDay, gotLastTradingDay) ||
+				!errors.Is(err1, test.wantErr) || !errors.Is(err2, test.wantErr) {
+				t.Errorf("%s error\nwant: %+v, %+v, %+v\ngot: %+v, %+v, %+v, %+v\n", t.Name(),
+					test.wantSQDate, test.wantLastTradingDay, test.wantErr, gotSQDate, gotLastTradingDay, err1, err2)
+			}
+		})
+	}
+}